@@ -2,6 +2,7 @@ package imgur
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -27,6 +28,11 @@ type GenerateAccessTokenResponse struct {
 
 // RefreshAccessToken let you reissue expired access_token
 func (c *Client) RefreshAccessToken(refreshToken string, clientSecret string) (string, error) {
+	return c.RefreshAccessTokenWithContext(context.Background(), refreshToken, clientSecret)
+}
+
+// RefreshAccessTokenWithContext is like RefreshAccessToken, but the request is bound to ctx
+func (c *Client) RefreshAccessTokenWithContext(ctx context.Context, refreshToken string, clientSecret string) (string, error) {
 	if len(refreshToken) == 0 {
 		msg := "Refresh token is empty"
 		c.Log.Errorf(msg)
@@ -53,15 +59,15 @@ func (c *Client) RefreshAccessToken(refreshToken string, clientSecret string) (s
 
 	c.Log.Debugf("Prepared body %v", string(rawBody))
 
-	url := apiEndpointGenerateAccessToken
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(rawBody))
+	url := c.createRootURL("oauth2/token")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(rawBody))
 	if err != nil {
 		c.Log.Errorf("Failed to create new request for refresh access token. %v", err)
 		return "", err
 	}
 
 	c.Log.Infof("Sending request to refresh access token")
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		c.Log.Errorf("HTTP request was failed. %v", err)
 		return "", err
@@ -74,6 +80,12 @@ func (c *Client) RefreshAccessToken(refreshToken string, clientSecret string) (s
 		return "", err
 	}
 
+	if !(resp.StatusCode >= 200 && resp.StatusCode <= 300) {
+		err = NewAPIError(req.Method, url, resp.StatusCode, body)
+		c.Log.Errorf("Refreshing the access token failed. %v", err)
+		return "", err
+	}
+
 	response := GenerateAccessTokenResponse{}
 	decoder := json.NewDecoder(bytes.NewReader(body))
 	decoder.UseNumber()
@@ -85,6 +97,6 @@ func (c *Client) RefreshAccessToken(refreshToken string, clientSecret string) (s
 	c.Log.Infof("Token was success updated and it will be relevant within next %v seconds", response.ExpiresIn)
 	c.Log.Debugf("New token: %v New refresh token: %v", response.AccessToken, response.RefreshToken)
 
-	c.imgurAccount.accessToken = response.AccessToken
+	c.setAccessToken(response.AccessToken)
 	return response.RefreshToken, nil
 }
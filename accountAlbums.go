@@ -0,0 +1,64 @@
+package imgur
+
+import (
+	"context"
+	"strconv"
+)
+
+// GetAccountAlbums lists a page of the albums created by a user, starting at page 0.
+// Pass Me for the user of an authenticated client.
+// returns the albums, status code of the request, error
+func (client *Client) GetAccountAlbums(ctx context.Context, username string, page int) ([]AlbumInfo, int, error) {
+	var albums []AlbumInfo
+	status, err := client.getAccount(ctx, username, "albums/"+strconv.Itoa(page), "albums", &albums)
+	return albums, status, err
+}
+
+// GetAccountAlbumIDs lists a page of the IDs of the albums created by a user.
+// returns the album IDs, status code of the request, error
+func (client *Client) GetAccountAlbumIDs(ctx context.Context, username string, page int) ([]AlbumID, int, error) {
+	var ids []AlbumID
+	status, err := client.getAccount(ctx, username, "albums/ids/"+strconv.Itoa(page), "album IDs", &ids)
+	return ids, status, err
+}
+
+// GetAccountAlbumCount queries imgur for the number of albums created by a user.
+// returns the number of albums, status code of the request, error
+func (client *Client) GetAccountAlbumCount(ctx context.Context, username string) (int, int, error) {
+	var count int
+	status, err := client.getAccount(ctx, username, "albums/count", "album count", &count)
+	return count, status, err
+}
+
+// AlbumIterator iterates over the albums of a listing, fetching pages as needed. The albums
+// are decoded one by one while the response is read, Close releases the response if
+// the iteration is stopped early.
+//
+//	it := client.AccountAlbums("me")
+//	defer it.Close()
+//	for it.Next(ctx) {
+//		fmt.Println(it.Album().Title)
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type AlbumIterator struct {
+	pager
+	album *AlbumInfo
+}
+
+// Next advances to the next album and reports whether there is one
+func (it *AlbumIterator) Next(ctx context.Context) bool {
+	it.album = &AlbumInfo{}
+	return it.next(ctx, it.album)
+}
+
+// Album returns the current album
+func (it *AlbumIterator) Album() *AlbumInfo {
+	return it.album
+}
+
+// AccountAlbums returns an iterator over all albums created by a user
+func (client *Client) AccountAlbums(username string) *AlbumIterator {
+	return &AlbumIterator{pager: client.accountPager(username, "albums", "albums")}
+}
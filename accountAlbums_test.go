@@ -0,0 +1,49 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAccountAlbums(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/3/account/Locker/albums/0":
+			fmt.Fprint(w, `{"data":[{"id":"VZQXk","title":"Cats","privacy":"hidden"}],"success":true,"status":200}`)
+		case "/3/account/Locker/albums/1":
+			fmt.Fprint(w, `{"data":[],"success":true,"status":200}`)
+		case "/3/account/Locker/albums/ids/0":
+			fmt.Fprint(w, `{"data":["VZQXk"],"success":true,"status":200}`)
+		case "/3/account/Locker/albums/count":
+			fmt.Fprint(w, `{"data":1,"success":true,"status":200}`)
+		default:
+			t.Errorf("unexpected request to %v", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	albums, status, err := client.GetAccountAlbums(context.Background(), "Locker", 0)
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Len(t, albums, 1)
+	require.Equal(t, "hidden", albums[0].Privacy)
+
+	ids, _, err := client.GetAccountAlbumIDs(context.Background(), "Locker", 0)
+	require.NoError(t, err)
+	require.Equal(t, []AlbumID{"VZQXk"}, ids)
+
+	count, _, err := client.GetAccountAlbumCount(context.Background(), "Locker")
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	it := client.AccountAlbums("Locker")
+	require.True(t, it.Next(context.Background()))
+	require.Equal(t, "Cats", it.Album().Title)
+	require.False(t, it.Next(context.Background()))
+	require.NoError(t, it.Err())
+}
@@ -0,0 +1,34 @@
+package imgur
+
+import "context"
+
+// Avatar is an avatar of an imgur user
+type Avatar struct {
+	Name     string `json:"name"`     // The name of the avatar, used to set it with UpdateAccountSettings
+	Location string `json:"location"` // The URL of the avatar image
+}
+
+// GetAccountAvailableAvatars lists the avatars the user of an authenticated client can choose from.
+// Set one with UpdateAccountSettings(ctx, SettingsPatch{Avatar: imgur.String(avatar.Name)}).
+// returns the avatars, status code of the request, error
+func (client *Client) GetAccountAvailableAvatars(ctx context.Context) ([]Avatar, int, error) {
+	var avatars struct {
+		Avatars []Avatar `json:"available_avatars"`
+	}
+	status, err := client.getAccount(ctx, Me, "available_avatars", "available avatars", &avatars)
+	return avatars.Avatars, status, err
+}
+
+// GetAccountAvatar queries imgur for the current avatar of a user
+// returns the avatar, status code of the request, error
+func (client *Client) GetAccountAvatar(ctx context.Context, username string) (*Avatar, int, error) {
+	var avatar struct {
+		Avatar     string `json:"avatar"`
+		AvatarName string `json:"avatar_name"`
+	}
+	status, err := client.getAccount(ctx, username, "avatar", "avatar", &avatar)
+	if err != nil {
+		return nil, status, err
+	}
+	return &Avatar{Name: avatar.AvatarName, Location: avatar.Avatar}, status, nil
+}
@@ -0,0 +1,42 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountAvatars(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/3/account/me/available_avatars":
+			fmt.Fprint(w, `{"data":{"available_avatars":[{"name":"cat","location":"https://imgur.com/user/avatars/cat.png"}],"num_available":1},"success":true,"status":200}`)
+		case "/3/account/Locker/avatar":
+			fmt.Fprint(w, `{"data":{"avatar":"https://imgur.com/user/avatars/dog.png","avatar_name":"dog"},"success":true,"status":200}`)
+		case "/3/account/me/settings":
+			require.NoError(t, r.ParseForm())
+			require.Equal(t, "cat", r.PostForm.Get("avatar"))
+			fmt.Fprint(w, `{"data":true,"success":true,"status":200}`)
+		default:
+			t.Errorf("unexpected request to %v", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	avatars, status, err := client.GetAccountAvailableAvatars(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Equal(t, []Avatar{{Name: "cat", Location: "https://imgur.com/user/avatars/cat.png"}}, avatars)
+
+	avatar, _, err := client.GetAccountAvatar(context.Background(), "Locker")
+	require.NoError(t, err)
+	require.Equal(t, "dog", avatar.Name)
+	require.Equal(t, "https://imgur.com/user/avatars/dog.png", avatar.Location)
+
+	_, err = client.UpdateAccountSettings(context.Background(), SettingsPatch{Avatar: String(avatars[0].Name)})
+	require.NoError(t, err)
+}
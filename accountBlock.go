@@ -0,0 +1,62 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// BlockedUser is a user blocked by the user of an authenticated client
+type BlockedUser struct {
+	URL string `json:"url"` // The username of the blocked user
+}
+
+type blockStatus struct {
+	Blocked bool `json:"blocked"`
+}
+
+// GetBlockStatus reports whether the user of an authenticated client blocked username.
+// returns true if username is blocked, status code of the request, error
+func (client *Client) GetBlockStatus(ctx context.Context, username string) (bool, int, error) {
+	var status blockStatus
+	code, err := client.changeBlock(ctx, "GET", username, &status)
+	return status.Blocked, code, err
+}
+
+// BlockUser blocks username for the user of an authenticated client.
+// returns status code of the request, error
+func (client *Client) BlockUser(ctx context.Context, username string) (int, error) {
+	return client.changeBlock(ctx, "POST", username, nil)
+}
+
+// UnblockUser removes the block of username.
+// returns status code of the request, error
+func (client *Client) UnblockUser(ctx context.Context, username string) (int, error) {
+	return client.changeBlock(ctx, "DELETE", username, nil)
+}
+
+func (client *Client) changeBlock(ctx context.Context, method string, username string, v interface{}) (int, error) {
+	if strings.TrimSpace(username) == "" || username == Me {
+		return -1, fmt.Errorf("Invalid username %q to block", username)
+	}
+	if !client.authenticated() {
+		return -1, fmt.Errorf("Blocking %v requires an access token - %w", username, ErrUnauthorized)
+	}
+
+	// the block endpoints are only available in version 1 of the account API
+	_, status, err := client.send(ctx, method, "/account/v1/"+username+"/block", nil, v)
+	if err != nil {
+		return status, fmt.Errorf("Problem with block of %v - %w", username, err)
+	}
+	return status, nil
+}
+
+// ListBlockedUsers lists the users blocked by the user of an authenticated client.
+// returns the blocked users, status code of the request, error
+func (client *Client) ListBlockedUsers(ctx context.Context) ([]BlockedUser, int, error) {
+	var blocks struct {
+		Items []BlockedUser `json:"items"`
+	}
+	status, err := client.getAccount(ctx, Me, "block", "blocked users", &blocks)
+	return blocks.Items, status, err
+}
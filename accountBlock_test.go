@@ -0,0 +1,62 @@
+package imgur
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockUser(t *testing.T) {
+	blocked := false
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/account/v1/troll/block", r.URL.Path)
+		switch r.Method {
+		case "POST":
+			blocked = true
+		case "DELETE":
+			blocked = false
+		}
+		fmt.Fprintf(w, `{"data":{"blocked":%v},"success":true,"status":200}`, blocked)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	status, err := client.BlockUser(context.Background(), "troll")
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+
+	isBlocked, _, err := client.GetBlockStatus(context.Background(), "troll")
+	require.NoError(t, err)
+	require.True(t, isBlocked)
+
+	_, err = client.UnblockUser(context.Background(), "troll")
+	require.NoError(t, err)
+	isBlocked, _, err = client.GetBlockStatus(context.Background(), "troll")
+	require.NoError(t, err)
+	require.False(t, isBlocked)
+
+	_, err = client.BlockUser(context.Background(), Me)
+	require.Error(t, err)
+
+	anonymous, _ := NewClient(httpC, "testing", "")
+	_, err = anonymous.BlockUser(context.Background(), "troll")
+	require.True(t, errors.Is(err, ErrUnauthorized))
+}
+
+func TestListBlockedUsers(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/3/account/me/block", r.URL.Path)
+		fmt.Fprint(w, `{"data":{"items":[{"url":"troll"},{"url":"spammer"}],"next":null},"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	users, status, err := client.ListBlockedUsers(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Equal(t, []BlockedUser{{URL: "troll"}, {URL: "spammer"}}, users)
+}
@@ -0,0 +1,63 @@
+package imgur
+
+import (
+	"context"
+	"strconv"
+)
+
+// GetAccountComments lists a page of the comments a user made, starting at page 0.
+// An empty sort defaults to CommentsNewest.
+// returns the comments, status code of the request, error
+func (client *Client) GetAccountComments(ctx context.Context, username string, sort CommentSort, page int) ([]Comment, int, error) {
+	var comments []Comment
+	status, err := client.getAccount(ctx, username, "comments/"+string(accountCommentSort(sort))+"/"+strconv.Itoa(page), "comments", &comments)
+	return comments, status, err
+}
+
+// GetAccountCommentIDs lists a page of the IDs of the comments a user made.
+// returns the comment IDs, status code of the request, error
+func (client *Client) GetAccountCommentIDs(ctx context.Context, username string, sort CommentSort, page int) ([]CommentID, int, error) {
+	var ids []CommentID
+	status, err := client.getAccount(ctx, username, "comments/ids/"+string(accountCommentSort(sort))+"/"+strconv.Itoa(page), "comment IDs", &ids)
+	return ids, status, err
+}
+
+// GetAccountCommentCount queries imgur for the number of comments a user made.
+// returns the number of comments, status code of the request, error
+func (client *Client) GetAccountCommentCount(ctx context.Context, username string) (int, int, error) {
+	var count int
+	status, err := client.getAccount(ctx, username, "comments/count", "comment count", &count)
+	return count, status, err
+}
+
+func accountCommentSort(sort CommentSort) CommentSort {
+	if sort == "" {
+		return CommentsNewest
+	}
+	return sort
+}
+
+// CommentIterator iterates over the comments of a listing, fetching pages as needed. The
+// comments are decoded one by one while the response is read, Close releases the response
+// if the iteration is stopped early.
+type CommentIterator struct {
+	pager
+	comment *Comment
+}
+
+// Next advances to the next comment and reports whether there is one
+func (it *CommentIterator) Next(ctx context.Context) bool {
+	it.comment = &Comment{}
+	return it.next(ctx, it.comment)
+}
+
+// Comment returns the current comment
+func (it *CommentIterator) Comment() *Comment {
+	return it.comment
+}
+
+// AccountComments returns an iterator over all comments a user made.
+// An empty sort defaults to CommentsNewest.
+func (client *Client) AccountComments(username string, sort CommentSort) *CommentIterator {
+	return &CommentIterator{pager: client.accountPager(username, "comments/"+string(accountCommentSort(sort)), "comments")}
+}
@@ -0,0 +1,64 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAccountComments(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/3/account/Locker/comments/newest/0":
+			fmt.Fprint(w, `{"data":[{"id":1,"image_id":"ClF8rLe","comment":"Nice cat","author":"Locker"}],"success":true,"status":200}`)
+		case "/3/account/Locker/comments/ids/worst/1":
+			fmt.Fprint(w, `{"data":[3,1],"success":true,"status":200}`)
+		case "/3/account/Locker/comments/count":
+			fmt.Fprint(w, `{"data":2,"success":true,"status":200}`)
+		default:
+			t.Errorf("unexpected request to %v", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	comments, status, err := client.GetAccountComments(context.Background(), "Locker", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Len(t, comments, 1)
+	require.Equal(t, "ClF8rLe", comments[0].ImageID)
+
+	ids, _, err := client.GetAccountCommentIDs(context.Background(), "Locker", CommentsWorst, 1)
+	require.NoError(t, err)
+	require.Equal(t, []CommentID{3, 1}, ids)
+
+	count, _, err := client.GetAccountCommentCount(context.Background(), "Locker")
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+}
+
+func TestAccountCommentsIterator(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/3/account/Locker/comments/best/0":
+			fmt.Fprint(w, `{"data":[{"id":1,"comment":"first"},{"id":2,"comment":"second"}],"success":true,"status":200}`)
+		case "/3/account/Locker/comments/best/1":
+			fmt.Fprint(w, `{"data":[],"success":true,"status":200}`)
+		default:
+			t.Errorf("unexpected request to %v", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	it := client.AccountComments("Locker", CommentsBest)
+	var comments []string
+	for it.Next(context.Background()) {
+		comments = append(comments, it.Comment().Comment)
+	}
+	require.NoError(t, it.Err())
+	require.Equal(t, []string{"first", "second"}, comments)
+}
@@ -0,0 +1,42 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// FavoriteSort is the order of favorite listings
+type FavoriteSort string
+
+// Orders of favorite listings
+const (
+	FavoritesNewest FavoriteSort = "newest"
+	FavoritesOldest FavoriteSort = "oldest"
+)
+
+// GetAccountFavorites lists a page of all images and albums a user favorited, which is
+// only allowed for the user of an authenticated client. An empty sort defaults to FavoritesNewest.
+// returns the favorites, status code of the request, error
+func (client *Client) GetAccountFavorites(ctx context.Context, username string, page int, sort FavoriteSort) ([]GalleryItem, int, error) {
+	if !client.authenticated() {
+		return nil, -1, fmt.Errorf("Requesting the favorites of %v requires an access token - %w", username, ErrUnauthorized)
+	}
+	return client.getAccountFavorites(ctx, username, "favorites", page, sort)
+}
+
+// GetAccountGalleryFavorites lists a page of the gallery posts a user favorited, which are public.
+// An empty sort defaults to FavoritesNewest.
+// returns the favorites, status code of the request, error
+func (client *Client) GetAccountGalleryFavorites(ctx context.Context, username string, page int, sort FavoriteSort) ([]GalleryItem, int, error) {
+	return client.getAccountFavorites(ctx, username, "gallery_favorites", page, sort)
+}
+
+func (client *Client) getAccountFavorites(ctx context.Context, username string, endpoint string, page int, sort FavoriteSort) ([]GalleryItem, int, error) {
+	if sort == "" {
+		sort = FavoritesNewest
+	}
+	var items []GalleryItem
+	status, err := client.getAccount(ctx, username, endpoint+"/"+strconv.Itoa(page)+"/"+string(sort), "favorites", &items)
+	return items, status, err
+}
@@ -0,0 +1,34 @@
+package imgur
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAccountFavorites(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Contains(t, []string{"/3/account/me/favorites/0/oldest", "/3/account/Locker/gallery_favorites/2/newest"}, r.URL.Path)
+		fmt.Fprint(w, gallerySearchJSON)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	items, status, err := client.GetAccountFavorites(context.Background(), Me, 0, FavoritesOldest)
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Len(t, items, 2)
+	require.True(t, items[1].IsAlbum())
+
+	anonymous, _ := NewClient(httpC, "testing", "")
+	_, _, err = anonymous.GetAccountFavorites(context.Background(), "Locker", 0, "")
+	require.True(t, errors.Is(err, ErrUnauthorized))
+
+	items, _, err = anonymous.GetAccountGalleryFavorites(context.Background(), "Locker", 2, "")
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+}
@@ -0,0 +1,64 @@
+package imgur
+
+import (
+	"context"
+	"strconv"
+)
+
+// GetAccountImages lists a page of the images uploaded by a user, starting at page 0.
+// Pass Me for the user of an authenticated client.
+// returns the images, status code of the request, error
+func (client *Client) GetAccountImages(ctx context.Context, username string, page int) ([]ImageInfo, int, error) {
+	var images []ImageInfo
+	status, err := client.getAccount(ctx, username, "images/"+strconv.Itoa(page), "images", &images)
+	return images, status, err
+}
+
+// GetAccountImageIDs lists a page of the IDs of the images uploaded by a user.
+// returns the image IDs, status code of the request, error
+func (client *Client) GetAccountImageIDs(ctx context.Context, username string, page int) ([]ImageID, int, error) {
+	var ids []ImageID
+	status, err := client.getAccount(ctx, username, "images/ids/"+strconv.Itoa(page), "image IDs", &ids)
+	return ids, status, err
+}
+
+// GetAccountImageCount queries imgur for the number of images uploaded by a user.
+// returns the number of images, status code of the request, error
+func (client *Client) GetAccountImageCount(ctx context.Context, username string) (int, int, error) {
+	var count int
+	status, err := client.getAccount(ctx, username, "images/count", "image count", &count)
+	return count, status, err
+}
+
+// ImageIterator iterates over the images of a listing, fetching pages as needed. The images
+// are decoded one by one while the response is read, Close releases the response if
+// the iteration is stopped early.
+//
+//	it := client.AccountImages("me")
+//	defer it.Close()
+//	for it.Next(ctx) {
+//		fmt.Println(it.Image().Link)
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type ImageIterator struct {
+	pager
+	image *ImageInfo
+}
+
+// Next advances to the next image and reports whether there is one
+func (it *ImageIterator) Next(ctx context.Context) bool {
+	it.image = &ImageInfo{}
+	return it.next(ctx, it.image)
+}
+
+// Image returns the current image
+func (it *ImageIterator) Image() *ImageInfo {
+	return it.image
+}
+
+// AccountImages returns an iterator over all images uploaded by a user
+func (client *Client) AccountImages(username string) *ImageIterator {
+	return &ImageIterator{pager: client.accountPager(username, "images", "images")}
+}
@@ -0,0 +1,81 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAccountImages(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/3/account/Locker/images/1":
+			fmt.Fprint(w, `{"data":[{"id":"ClF8rLe","deletehash":"hash"}],"success":true,"status":200}`)
+		case "/3/account/Locker/images/ids/0":
+			fmt.Fprint(w, `{"data":["ClF8rLe","CJCA0gW"],"success":true,"status":200}`)
+		case "/3/account/Locker/images/count":
+			fmt.Fprint(w, `{"data":2,"success":true,"status":200}`)
+		default:
+			t.Errorf("unexpected request to %v", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	images, status, err := client.GetAccountImages(context.Background(), "Locker", 1)
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Len(t, images, 1)
+	require.Equal(t, DeleteHash("hash"), images[0].Deletehash)
+
+	ids, _, err := client.GetAccountImageIDs(context.Background(), "Locker", 0)
+	require.NoError(t, err)
+	require.Equal(t, ImageIDs("ClF8rLe", "CJCA0gW"), ids)
+
+	count, _, err := client.GetAccountImageCount(context.Background(), "Locker")
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+}
+
+func TestAccountImagesIterator(t *testing.T) {
+	pages := map[string]string{
+		"/3/account/me/images/0": `[{"id":"a"},{"id":"b"}]`,
+		"/3/account/me/images/1": `[{"id":"c"}]`,
+		"/3/account/me/images/2": `[]`,
+	}
+	requests := 0
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		page, ok := pages[r.URL.Path]
+		require.True(t, ok, r.URL.Path)
+		fmt.Fprint(w, `{"data":`+page+`,"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	it := client.AccountImages(Me)
+	var ids []string
+	for it.Next(context.Background()) {
+		ids = append(ids, string(it.Image().ID))
+	}
+	require.NoError(t, it.Err())
+	require.Equal(t, []string{"a", "b", "c"}, ids)
+	require.Equal(t, 3, requests)
+
+	// an exhausted iterator stays exhausted
+	require.False(t, it.Next(context.Background()))
+	require.Equal(t, 3, requests)
+}
+
+func TestAccountImagesIteratorError(t *testing.T) {
+	httpC, server := testHTTPClient500()
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithRetryPolicy(testRetryPolicy))
+	it := client.AccountImages("Locker")
+	require.False(t, it.Next(context.Background()))
+	require.Error(t, it.Err())
+}
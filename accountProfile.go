@@ -0,0 +1,152 @@
+package imgur
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Me is the username that refers to the user of an authenticated client
+const Me = "me"
+
+// Account contains the public profile of an imgur user
+type Account struct {
+	ID             int             `json:"id"`              // The account ID
+	URL            string          `json:"url"`             // The username of the account
+	Bio            string          `json:"bio"`             // A basic description the user has filled out
+	Avatar         string          `json:"avatar"`          // The URL of the avatar of the user
+	Reputation     float64         `json:"reputation"`      // The reputation of the account
+	ReputationName string          `json:"reputation_name"` // The reputation level, like "Neutral"
+	Created        UnixTime        `json:"created"`         // Time the account was created, epoch time
+	ProExpiration  int64           `json:"-"`               // Time the pro subscription expires, epoch time, 0 if the user is not pro
+	Limit          *RateLimit      `json:"-"`               // Current rate limit
+	Raw            json.RawMessage `json:"-"`               // The JSON sent by imgur, only kept with WithRawJSON
+}
+
+// CreatedAt returns when the account was created
+func (a *Account) CreatedAt() time.Time {
+	return a.Created.Time()
+}
+
+// IsPro reports whether the user has an active pro subscription
+func (a *Account) IsPro() bool {
+	return a.ProExpiration > time.Now().Unix()
+}
+
+// UnmarshalJSON decodes pro_expiration, which imgur sends as false for users without pro
+func (a *Account) UnmarshalJSON(data []byte) error {
+	type account Account
+	aux := struct {
+		*account
+		ProExpiration interface{} `json:"pro_expiration"`
+	}{account: (*account)(a)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	if expiration, ok := aux.ProExpiration.(float64); ok {
+		a.ProExpiration = int64(expiration)
+	}
+	return nil
+}
+
+// accountPath returns the path of an account endpoint, username may be Me
+func (client *Client) accountPath(username string, endpoint string) (string, error) {
+	if strings.TrimSpace(username) == "" {
+		return "", fmt.Errorf("Username is empty")
+	}
+	if username == Me && !client.authenticated() {
+		return "", fmt.Errorf("Requesting the own account requires an access token - %w", ErrUnauthorized)
+	}
+	path := "account/" + username
+	if endpoint != "" {
+		path += "/" + endpoint
+	}
+	return path, nil
+}
+
+// accountPager returns a pager over the pages of an account listing
+func (client *Client) accountPager(username string, endpoint string, what string) pager {
+	return pager{
+		client: client,
+		path: func(page int) (string, error) {
+			return client.accountPath(username, endpoint+"/"+strconv.Itoa(page))
+		},
+		what: what + " of account " + username,
+	}
+}
+
+// getAccount requests an account endpoint and decodes its data into v, what names the data in errors
+func (client *Client) getAccount(ctx context.Context, username string, endpoint string, what string, v interface{}) (int, error) {
+	path, err := client.accountPath(username, endpoint)
+	if err != nil {
+		return -1, err
+	}
+	_, status, err := client.send(ctx, "GET", path, nil, v)
+	if err != nil {
+		return status, fmt.Errorf("Problem getting %v of account %v - %w", what, username, err)
+	}
+	return status, nil
+}
+
+// GetAccount queries imgur for the profile of a user. Pass Me for the user of an authenticated client.
+// returns the account, status code of the request, error
+func (client *Client) GetAccount(ctx context.Context, username string) (*Account, int, error) {
+	path, err := client.accountPath(username, "")
+	if err != nil {
+		return nil, -1, err
+	}
+
+	account := &Account{}
+	rl, status, err := client.send(ctx, "GET", path, nil, account)
+	if err != nil {
+		return nil, status, fmt.Errorf("Problem getting account %v - %w", username, err)
+	}
+	account.Limit = rl
+	return account, status, nil
+}
+
+// Trophy is an award imgur gave a user
+type Trophy struct {
+	ID          int      `json:"id"`          // The ID of the trophy, unique for every trophy and user
+	Name        string   `json:"name"`        // The name of the trophy
+	NameClean   string   `json:"name_clean"`  // The name of the trophy usable in URLs
+	Description string   `json:"description"` // The description of the trophy and how it is earned
+	Data        string   `json:"data"`        // Why the user got the trophy
+	DataLink    string   `json:"data_link"`   // A link to the reason of the trophy, if any
+	Datetime    UnixTime `json:"datetime"`    // Time the user got the trophy, epoch time
+	Image       string   `json:"image"`       // The URL of the image of the trophy
+}
+
+// AwardedAt returns when the user got the trophy
+func (t *Trophy) AwardedAt() time.Time {
+	return t.Datetime.Time()
+}
+
+// GalleryProfile summarizes the gallery activity of a user
+type GalleryProfile struct {
+	TotalGalleryComments    int        `json:"total_gallery_comments"`    // Number of comments on gallery posts
+	TotalGalleryFavorites   int        `json:"total_gallery_favorites"`   // Number of gallery posts favorited
+	TotalGallerySubmissions int        `json:"total_gallery_submissions"` // Number of posts submitted to the gallery
+	Trophies                []Trophy   `json:"trophies"`                  // The trophies of the user
+	Limit                   *RateLimit `json:"-"`                         // Current rate limit
+}
+
+// GetAccountGalleryProfile queries imgur for the gallery profile of a user, including the trophies
+// returns the gallery profile, status code of the request, error
+func (client *Client) GetAccountGalleryProfile(ctx context.Context, username string) (*GalleryProfile, int, error) {
+	path, err := client.accountPath(username, "gallery_profile")
+	if err != nil {
+		return nil, -1, err
+	}
+
+	profile := &GalleryProfile{}
+	rl, status, err := client.send(ctx, "GET", path, nil, profile)
+	if err != nil {
+		return nil, status, fmt.Errorf("Problem getting gallery profile of account %v - %w", username, err)
+	}
+	profile.Limit = rl
+	return profile, status, nil
+}
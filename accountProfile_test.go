@@ -0,0 +1,65 @@
+package imgur
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAccount(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/3/account/Locker":
+			fmt.Fprint(w, `{"data":{"id":42,"url":"Locker","bio":"Cats","reputation":1234.5,"reputation_name":"Glorious","created":1460715031,"pro_expiration":false},"success":true,"status":200}`)
+		case "/3/account/me":
+			require.Equal(t, "Bearer access", r.Header.Get("Authorization"))
+			fmt.Fprint(w, `{"data":{"id":7,"url":"self","created":1460715031,"pro_expiration":`+fmt.Sprint(time.Now().Add(time.Hour).Unix())+`},"success":true,"status":200}`)
+		default:
+			t.Errorf("unexpected request to %v", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	account, status, err := client.GetAccount(context.Background(), "Locker")
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Equal(t, 42, account.ID)
+	require.Equal(t, "Cats", account.Bio)
+	require.Equal(t, 1234.5, account.Reputation)
+	require.Equal(t, int64(1460715031), account.CreatedAt().Unix())
+	require.False(t, account.IsPro())
+
+	_, _, err = client.GetAccount(context.Background(), Me)
+	require.True(t, errors.Is(err, ErrUnauthorized))
+
+	user, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	account, _, err = user.GetAccount(context.Background(), Me)
+	require.NoError(t, err)
+	require.Equal(t, "self", account.URL)
+	require.True(t, account.IsPro())
+}
+
+func TestGetAccountGalleryProfile(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/3/account/Locker/gallery_profile", r.URL.Path)
+		fmt.Fprint(w, `{"data":{"total_gallery_comments":40,"total_gallery_favorites":12,"total_gallery_submissions":3,"trophies":[{"id":1,"name":"1 Year","name_clean":"1Years","description":"Be a member of Imgur for one year.","data":null,"data_link":null,"datetime":1357344455,"image":"https://s.imgur.com/images/trophies/a84ade.png"}]},"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	profile, status, err := client.GetAccountGalleryProfile(context.Background(), "Locker")
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Equal(t, 40, profile.TotalGalleryComments)
+	require.Equal(t, 3, profile.TotalGallerySubmissions)
+	require.Len(t, profile.Trophies, 1)
+	require.Equal(t, "1Years", profile.Trophies[0].NameClean)
+	require.Equal(t, UnixTime(1357344455), profile.Trophies[0].Datetime)
+	require.True(t, time.Unix(1357344455, 0).Equal(profile.Trophies[0].AwardedAt()))
+}
@@ -0,0 +1,133 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// AccountSettings are the settings of the user of an authenticated client
+type AccountSettings struct {
+	AccountURL           string       `json:"account_url"`            // The username of the account
+	Email                string       `json:"email"`                  // The email address of the account
+	Avatar               string       `json:"avatar"`                 // The name of the avatar of the account
+	Cover                string       `json:"cover"`                  // The name of the profile cover of the account
+	PublicImages         bool         `json:"public_images"`          // If new images are public by default
+	AlbumPrivacy         AlbumPrivacy `json:"album_privacy"`          // The default privacy of new albums
+	AcceptedGalleryTerms bool         `json:"accepted_gallery_terms"` // If the user accepted the terms for submitting to the gallery
+	ActiveEmails         []string     `json:"active_emails"`          // The email addresses that can be used to log in
+	MessagingEnabled     bool         `json:"messaging_enabled"`      // If other users can send messages to the user
+	ShowMature           bool         `json:"show_mature"`            // If mature posts are shown in the gallery
+	Limit                *RateLimit   `json:"-"`                      // Current rate limit
+}
+
+// SettingsPatch changes the settings of an account. Fields that are nil are left untouched.
+//
+//	client.UpdateAccountSettings(ctx, SettingsPatch{Bio: imgur.String("Cats"), ShowMature: imgur.Bool(false)})
+type SettingsPatch struct {
+	Bio              *string       // The biography shown on the profile
+	PublicImages     *bool         // If new images are public by default
+	MessagingEnabled *bool         // If other users can send messages to the user
+	AlbumPrivacy     *AlbumPrivacy // The default privacy of new albums
+	ShowMature       *bool         // If mature posts are shown in the gallery
+	Username         *string       // Changes the username of the account
+	Avatar           *string       // Name of the new avatar, one of GetAccountAvailableAvatars
+}
+
+// String returns a pointer to s, for the optional fields of SettingsPatch
+func String(s string) *string {
+	return &s
+}
+
+// Bool returns a pointer to b, for the optional fields of SettingsPatch
+func Bool(b bool) *bool {
+	return &b
+}
+
+func (p *SettingsPatch) values() url.Values {
+	v := url.Values{}
+	if p.Bio != nil {
+		v.Set("bio", *p.Bio)
+	}
+	if p.PublicImages != nil {
+		v.Set("public_images", strconv.FormatBool(*p.PublicImages))
+	}
+	if p.MessagingEnabled != nil {
+		v.Set("messaging_enabled", strconv.FormatBool(*p.MessagingEnabled))
+	}
+	if p.AlbumPrivacy != nil {
+		v.Set("album_privacy", string(*p.AlbumPrivacy))
+	}
+	if p.ShowMature != nil {
+		v.Set("show_mature", strconv.FormatBool(*p.ShowMature))
+	}
+	if p.Username != nil {
+		v.Set("username", *p.Username)
+	}
+	if p.Avatar != nil {
+		v.Set("avatar", *p.Avatar)
+	}
+	return v
+}
+
+// GetAccountSettings queries imgur for the settings of the user, which requires an authenticated client.
+// returns the settings, status code of the request, error
+func (client *Client) GetAccountSettings(ctx context.Context) (*AccountSettings, int, error) {
+	path, err := client.accountPath(Me, "settings")
+	if err != nil {
+		return nil, -1, err
+	}
+
+	settings := &AccountSettings{}
+	rl, status, err := client.send(ctx, "GET", path, nil, settings)
+	if err != nil {
+		return nil, status, fmt.Errorf("Problem getting account settings - %w", err)
+	}
+	settings.Limit = rl
+	return settings, status, nil
+}
+
+// UpdateAccountSettings changes the settings of the user that are set in patch,
+// which requires an authenticated client.
+// returns status code of the request, error
+func (client *Client) UpdateAccountSettings(ctx context.Context, patch SettingsPatch) (int, error) {
+	v := patch.values()
+	if len(v) == 0 {
+		return -1, fmt.Errorf("Settings patch is empty")
+	}
+	path, err := client.accountPath(Me, "settings")
+	if err != nil {
+		return -1, err
+	}
+
+	_, status, err := client.send(ctx, "PUT", path, v, nil)
+	if err != nil {
+		return status, fmt.Errorf("Problem updating account settings - %w", err)
+	}
+	return status, nil
+}
+
+// HasVerifiedEmail reports whether the user verified the email address of the account,
+// which requires an authenticated client.
+// returns true if the email is verified, status code of the request, error
+func (client *Client) HasVerifiedEmail(ctx context.Context) (bool, int, error) {
+	var verified bool
+	status, err := client.getAccount(ctx, Me, "verifyemail", "email verification status", &verified)
+	return verified, status, err
+}
+
+// SendVerificationEmail asks imgur to send a verification email to the user, which requires an authenticated client.
+// returns status code of the request, error
+func (client *Client) SendVerificationEmail(ctx context.Context) (int, error) {
+	path, err := client.accountPath(Me, "verifyemail")
+	if err != nil {
+		return -1, err
+	}
+
+	_, status, err := client.send(ctx, "POST", path, nil, nil)
+	if err != nil {
+		return status, fmt.Errorf("Problem sending verification email - %w", err)
+	}
+	return status, nil
+}
@@ -0,0 +1,90 @@
+package imgur
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAccountSettings(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "GET", r.Method)
+		require.Equal(t, "/3/account/me/settings", r.URL.Path)
+		fmt.Fprint(w, `{"data":{"account_url":"Locker","email":"locker@example.com","public_images":false,"album_privacy":"secret","pro_expiration":false,"accepted_gallery_terms":true,"active_emails":[],"messaging_enabled":true,"blocked_users":[],"show_mature":false},"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	settings, status, err := client.GetAccountSettings(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Equal(t, "Locker", settings.AccountURL)
+	require.Equal(t, PrivacySecret, settings.AlbumPrivacy)
+	require.True(t, settings.MessagingEnabled)
+
+	anonymous, _ := NewClient(httpC, "testing", "")
+	_, _, err = anonymous.GetAccountSettings(context.Background())
+	require.True(t, errors.Is(err, ErrUnauthorized))
+}
+
+func TestUpdateAccountSettings(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "PUT", r.Method)
+		require.Equal(t, "/3/account/me/settings", r.URL.Path)
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "", r.PostForm.Get("bio"))
+		require.Contains(t, r.PostForm, "bio")
+		require.Equal(t, "false", r.PostForm.Get("show_mature"))
+		require.Equal(t, "hidden", r.PostForm.Get("album_privacy"))
+		require.Equal(t, "NewName", r.PostForm.Get("username"))
+		require.NotContains(t, r.PostForm, "public_images")
+		require.NotContains(t, r.PostForm, "messaging_enabled")
+		fmt.Fprint(w, `{"data":true,"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	privacy := PrivacyHidden
+	status, err := client.UpdateAccountSettings(context.Background(), SettingsPatch{
+		Bio:          String(""),
+		ShowMature:   Bool(false),
+		AlbumPrivacy: &privacy,
+		Username:     String("NewName"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+
+	_, err = client.UpdateAccountSettings(context.Background(), SettingsPatch{})
+	require.Error(t, err)
+}
+
+func TestVerificationEmail(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/3/account/me/verifyemail", r.URL.Path)
+		if r.Method == "GET" {
+			fmt.Fprint(w, `{"data":false,"success":true,"status":200}`)
+			return
+		}
+		require.Equal(t, "POST", r.Method)
+		fmt.Fprint(w, `{"data":true,"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	verified, status, err := client.HasVerifiedEmail(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.False(t, verified)
+
+	status, err = client.SendVerificationEmail(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+
+	anonymous, _ := NewClient(httpC, "testing", "")
+	_, err = anonymous.SendVerificationEmail(context.Background())
+	require.True(t, errors.Is(err, ErrUnauthorized))
+}
@@ -0,0 +1,14 @@
+package imgur
+
+import (
+	"context"
+	"strconv"
+)
+
+// GetAccountSubmissions lists a page of the posts a user submitted to the public gallery, starting at page 0.
+// returns the images and albums, status code of the request, error
+func (client *Client) GetAccountSubmissions(ctx context.Context, username string, page int) ([]GalleryItem, int, error) {
+	var items []GalleryItem
+	status, err := client.getAccount(ctx, username, "submissions/"+strconv.Itoa(page), "submissions", &items)
+	return items, status, err
+}
@@ -0,0 +1,29 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAccountSubmissions(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/3/account/Locker/submissions/1", r.URL.Path)
+		fmt.Fprint(w, gallerySearchJSON)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	items, status, err := client.GetAccountSubmissions(context.Background(), "Locker", 1)
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Len(t, items, 2)
+	require.Equal(t, ImageID("ClF8rLe"), items[0].AsImage().ID)
+	require.Equal(t, AlbumID("VZQXk"), items[1].AsAlbum().ID)
+
+	_, _, err = client.GetAccountSubmissions(context.Background(), "", 0)
+	require.Error(t, err)
+}
@@ -1,10 +1,11 @@
 package imgur
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
-	"strconv"
+	"fmt"
 	"strings"
+	"time"
 )
 
 type albumInfoDataWrapper struct {
@@ -15,49 +16,62 @@ type albumInfoDataWrapper struct {
 
 // AlbumInfo contains all album information provided by imgur
 type AlbumInfo struct {
-	ID          string      `json:"id"`                   // The ID for the album
-	Title       string      `json:"title"`                // The title of the album in the gallery
-	Description string      `json:"description"`          // The description of the album in the gallery
-	DateTime    int         `json:"datetime"`             // Time inserted into the gallery, epoch time
-	Cover       string      `json:"cover"`                // The ID of the album cover image
-	CoverWidth  int         `json:"cover_width"`          // The width, in pixels, of the album cover image
-	CoverHeight int         `json:"cover_height"`         // The height, in pixels, of the album cover image
-	AccountURL  string      `json:"account_url"`          // The account username or null if it's anonymous.
-	AccountID   int         `json:"account_id"`           // The account ID or null if it's anonymous.
-	Privacy     string      `json:"privacy"`              // The privacy level of the album, you can only view public if not logged in as album owner
-	Layout      string      `json:"layout"`               // The view layout of the album.
-	Views       int         `json:"views"`                // The number of album views
-	Link        string      `json:"link"`                 // The URL link to the album
-	Favorite    bool        `json:"favorite"`             // Indicates if the current user favorited the image. Defaults to false if not signed in.
-	Nsfw        bool        `json:"nsfw"`                 // Indicates if the image has been marked as nsfw or not. Defaults to null if information is not available.
-	Section     string      `json:"section"`              // If the image has been categorized by our backend then this will contain the section the image belongs in. (funny, cats, adviceanimals, wtf, etc)
-	Order       int         `json:"order"`                // Order number of the album on the user's album page (defaults to 0 if their albums haven't been reordered)
-	Deletehash  string      `json:"deletehash,omitempty"` // OPTIONAL, the deletehash, if you're logged in as the album owner
-	ImagesCount int         `json:"images_count"`         // The total number of images in the album
-	Images      []ImageInfo `json:"images"`               // An array of all the images in the album (only available when requesting the direct album)
-	InGallery   bool        `json:"in_gallery"`           // True if the image has been submitted to the gallery, false if otherwise.
-	Limit       *RateLimit  // Current rate limit
+	ID          AlbumID         `json:"id"`                   // The ID for the album
+	Title       string          `json:"title"`                // The title of the album in the gallery
+	Description string          `json:"description"`          // The description of the album in the gallery
+	DateTime    UnixTime        `json:"datetime"`             // Time inserted into the gallery, epoch time
+	Cover       ImageID         `json:"cover"`                // The ID of the album cover image
+	CoverWidth  int             `json:"cover_width"`          // The width, in pixels, of the album cover image
+	CoverHeight int             `json:"cover_height"`         // The height, in pixels, of the album cover image
+	AccountURL  string          `json:"account_url"`          // The account username or null if it's anonymous.
+	AccountID   int             `json:"account_id"`           // The account ID or null if it's anonymous.
+	Privacy     string          `json:"privacy"`              // The privacy level of the album, you can only view public if not logged in as album owner
+	Layout      string          `json:"layout"`               // The view layout of the album.
+	Views       int             `json:"views"`                // The number of album views
+	Link        string          `json:"link"`                 // The URL link to the album
+	Favorite    bool            `json:"favorite"`             // Indicates if the current user favorited the image. Defaults to false if not signed in.
+	Nsfw        bool            `json:"nsfw"`                 // Indicates if the image has been marked as nsfw or not. Defaults to null if information is not available.
+	Section     string          `json:"section"`              // If the image has been categorized by our backend then this will contain the section the image belongs in. (funny, cats, adviceanimals, wtf, etc)
+	Order       int             `json:"order"`                // Order number of the album on the user's album page (defaults to 0 if their albums haven't been reordered)
+	Deletehash  DeleteHash      `json:"deletehash,omitempty"` // OPTIONAL, the deletehash, if you're logged in as the album owner
+	ImagesCount int             `json:"images_count"`         // The total number of images in the album
+	Images      []ImageInfo     `json:"images"`               // An array of all the images in the album (only available when requesting the direct album)
+	InGallery   bool            `json:"in_gallery"`           // True if the image has been submitted to the gallery, false if otherwise.
+	Limit       *RateLimit      // Current rate limit
+	Raw         json.RawMessage `json:"-"` // The JSON sent by imgur, only kept with WithRawJSON
+}
+
+// CreatedAt returns when the album was created
+func (ai *AlbumInfo) CreatedAt() time.Time {
+	return ai.DateTime.Time()
 }
 
 // GetAlbumInfo queries imgur for information on a album
 // returns album info, status code of the request, error
-func (client *Client) GetAlbumInfo(id string) (*AlbumInfo, int, error) {
-	body, rl, err := client.getURL("album/" + id)
+func (client *Client) GetAlbumInfo(id AlbumID) (*AlbumInfo, int, error) {
+	return client.GetAlbumInfoWithContext(context.Background(), id)
+}
+
+// GetAlbumInfoWithContext is like GetAlbumInfo, but the request is bound to ctx
+func (client *Client) GetAlbumInfoWithContext(ctx context.Context, id AlbumID) (*AlbumInfo, int, error) {
+	path := "album/" + string(id)
+	body, rl, err := client.getURL(ctx, path)
 	if err != nil {
-		return nil, -1, errors.New("Problem getting URL for album info ID " + id + " - " + err.Error())
+		return nil, -1, fmt.Errorf("Problem getting URL for album info ID %v - %w", id, err)
 	}
 	//client.Log.Debugf("%v\n", body)
 
 	dec := json.NewDecoder(strings.NewReader(body))
 	var alb albumInfoDataWrapper
 	if err := dec.Decode(&alb); err != nil {
-		return nil, -1, errors.New("Problem decoding json for albumID " + id + " - " + err.Error())
+		return nil, -1, fmt.Errorf("Problem decoding json for albumID %v - %w", id, err)
 	}
 
 	if !alb.Success {
-		return nil, alb.Status, errors.New("Request to imgur failed for albumID " + id + " - " + strconv.Itoa(alb.Status))
+		return nil, alb.Status, fmt.Errorf("Request to imgur failed for albumID %v - %w", id, NewAPIError("GET", client.createAPIURL(path), alb.Status, []byte(body)))
 	}
 
 	alb.Ai.Limit = rl
+	client.keepRawJSON([]byte(body), alb.Ai)
 	return alb.Ai, alb.Status, nil
 }
@@ -0,0 +1,230 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// AlbumPrivacy controls who can see an album
+type AlbumPrivacy string
+
+// Privacy levels of an album
+const (
+	PrivacyPublic AlbumPrivacy = "public" // Everyone can see the album
+	PrivacyHidden AlbumPrivacy = "hidden" // Only people knowing the link can see the album
+	PrivacySecret AlbumPrivacy = "secret" // Only the owner can see the album
+)
+
+// AlbumLayout is the way an album is presented on imgur
+type AlbumLayout string
+
+// Layouts of an album
+const (
+	LayoutBlog       AlbumLayout = "blog"
+	LayoutGrid       AlbumLayout = "grid"
+	LayoutHorizontal AlbumLayout = "horizontal"
+	LayoutVertical   AlbumLayout = "vertical"
+)
+
+// AlbumOptions are the parameters of a created or updated album. Empty fields are not sent.
+type AlbumOptions struct {
+	Title        string       // The title of the album
+	Description  string       // The description of the album
+	Privacy      AlbumPrivacy // Who can see the album
+	Layout       AlbumLayout  // How the album is displayed
+	Cover        ImageID      // The ID of the image used as album cover
+	ImageIDs     []ImageID    // The IDs of the images in the album, requires an authenticated client
+	DeleteHashes []DeleteHash // The deletehashes of the images in an anonymous album
+}
+
+func (o *AlbumOptions) values() url.Values {
+	v := url.Values{}
+	if o.Title != "" {
+		v.Set("title", o.Title)
+	}
+	if o.Description != "" {
+		v.Set("description", o.Description)
+	}
+	if o.Privacy != "" {
+		v.Set("privacy", string(o.Privacy))
+	}
+	if o.Layout != "" {
+		v.Set("layout", string(o.Layout))
+	}
+	if o.Cover != "" {
+		v.Set("cover", string(o.Cover))
+	}
+	for _, id := range o.ImageIDs {
+		v.Add("ids[]", string(id))
+	}
+	for _, hash := range o.DeleteHashes {
+		v.Add("deletehashes[]", string(hash))
+	}
+	return v
+}
+
+// CreatedAlbum identifies an album created with CreateAlbum
+type CreatedAlbum struct {
+	ID         AlbumID    `json:"id"`         // The ID of the album
+	Deletehash DeleteHash `json:"deletehash"` // Needed to update or delete an anonymous album
+	Anonymous  bool       `json:"-"`          // If the album belongs to no account and can only be changed with Deletehash
+	Limit      *RateLimit `json:"-"`          // Current rate limit
+}
+
+// Ref returns the reference to change the album with, the Deletehash for anonymous albums
+// and the ID otherwise
+func (a *CreatedAlbum) Ref() AlbumRef {
+	if a.Anonymous {
+		return a.Deletehash
+	}
+	return a.ID
+}
+
+// CreateAlbum creates a new album. Anonymous albums can only be changed with the returned deletehash.
+// returns the created album, status code of the request, error
+func (client *Client) CreateAlbum(ctx context.Context, opts AlbumOptions) (*CreatedAlbum, int, error) {
+	album := &CreatedAlbum{Anonymous: isAnonymous(ctx) || !client.authenticated()}
+	rl, status, err := client.send(ctx, "POST", "album", opts.values(), album)
+	if err != nil {
+		return nil, status, fmt.Errorf("Problem creating album - %w", err)
+	}
+	album.Limit = rl
+	return album, status, nil
+}
+
+// CreateAnonymousAlbum creates an album that belongs to no account, even if the client is
+// authenticated. The images are given by their deletehashes in opts.DeleteHashes, the album
+// can only be changed with the returned deletehash, e.g. by AddImagesToAnonymousAlbum.
+// returns the created album, status code of the request, error
+func (client *Client) CreateAnonymousAlbum(ctx context.Context, opts AlbumOptions) (*CreatedAlbum, int, error) {
+	if len(opts.ImageIDs) > 0 {
+		return nil, -1, fmt.Errorf("Anonymous albums are created with the deletehashes of the images, not their IDs")
+	}
+	return client.CreateAlbum(contextAnonymous(ctx), opts)
+}
+
+// AddImagesToAnonymousAlbum adds the images with the given deletehashes to the anonymous
+// album, which is changed with its Deletehash.
+// returns status code of the request, error
+func (client *Client) AddImagesToAnonymousAlbum(ctx context.Context, album *CreatedAlbum, images ...DeleteHash) (int, error) {
+	if album == nil || album.Deletehash == "" {
+		return -1, fmt.Errorf("Album deletehash is empty")
+	}
+	refs := make([]ImageRef, len(images))
+	for i, img := range images {
+		refs[i] = img
+	}
+	return client.changeAlbumImages(contextAnonymous(ctx), "add", album.Deletehash, refs)
+}
+
+// DeleteAnonymousAlbum deletes the anonymous album with its Deletehash.
+// The images in the album are not deleted.
+// returns status code of the request, error
+func (client *Client) DeleteAnonymousAlbum(ctx context.Context, album *CreatedAlbum) (int, error) {
+	if album == nil || album.Deletehash == "" {
+		return -1, fmt.Errorf("Album deletehash is empty")
+	}
+	return client.DeleteAlbum(contextAnonymous(ctx), album.Deletehash)
+}
+
+type anonymousKey struct{}
+
+// contextAnonymous sends all requests bound to ctx with the client ID instead of the access token of the user
+func contextAnonymous(ctx context.Context) context.Context {
+	return context.WithValue(ctx, anonymousKey{}, true)
+}
+
+// isAnonymous reports if a request bound to ctx is sent without the access token of the user
+func isAnonymous(ctx context.Context) bool {
+	anonymous, _ := ctx.Value(anonymousKey{}).(bool)
+	return anonymous
+}
+
+// UpdateAlbum changes the album with the given AlbumID, or DeleteHash for anonymous albums.
+// Only the fields set in opts are changed, except for the images which are replaced if any are given.
+// returns status code of the request, error
+func (client *Client) UpdateAlbum(ctx context.Context, album AlbumRef, opts AlbumOptions) (int, error) {
+	id := refString(album)
+	if id == "" {
+		return -1, fmt.Errorf("Album ID is empty")
+	}
+	_, status, err := client.send(ctx, "PUT", "album/"+id, opts.values(), nil)
+	if err != nil {
+		return status, fmt.Errorf("Problem updating album %v - %w", id, err)
+	}
+	return status, nil
+}
+
+// SetAlbumCover makes the image with the given ID the cover of the album.
+// returns status code of the request, error
+func (client *Client) SetAlbumCover(ctx context.Context, album AlbumRef, imageID ImageID) (int, error) {
+	if refString(imageID) == "" {
+		return -1, fmt.Errorf("Cover image ID is empty")
+	}
+	return client.UpdateAlbum(ctx, album, AlbumOptions{Cover: imageID})
+}
+
+// DeleteAlbum deletes the album with the given AlbumID, or DeleteHash for anonymous albums.
+// The images in the album are not deleted.
+// returns status code of the request, error
+func (client *Client) DeleteAlbum(ctx context.Context, album AlbumRef) (int, error) {
+	id := refString(album)
+	if id == "" {
+		return -1, fmt.Errorf("Album ID is empty")
+	}
+	_, status, err := client.send(ctx, "DELETE", "album/"+id, nil, nil)
+	if err != nil {
+		return status, fmt.Errorf("Problem deleting album %v - %w", id, err)
+	}
+	return status, nil
+}
+
+// AddImagesToAlbum adds images to an album. Anonymous albums are changed with the
+// DeleteHash of the album and the DeleteHashes of the images, an image reference can
+// not mix ImageIDs and DeleteHashes.
+// returns status code of the request, error
+func (client *Client) AddImagesToAlbum(ctx context.Context, album AlbumRef, images ...ImageRef) (int, error) {
+	return client.changeAlbumImages(ctx, "add", album, images)
+}
+
+// RemoveImagesFromAlbum removes images from an album. The images are not deleted.
+// Anonymous albums are changed with the DeleteHash of the album and the DeleteHashes of the images.
+// returns status code of the request, error
+func (client *Client) RemoveImagesFromAlbum(ctx context.Context, album AlbumRef, images ...ImageRef) (int, error) {
+	return client.changeAlbumImages(ctx, "remove_images", album, images)
+}
+
+func (client *Client) changeAlbumImages(ctx context.Context, action string, album AlbumRef, images []ImageRef) (int, error) {
+	id := refString(album)
+	if id == "" {
+		return -1, fmt.Errorf("Album ID is empty")
+	}
+	if len(images) == 0 {
+		return -1, fmt.Errorf("No images given for album %v", id)
+	}
+
+	// imgur expects either ids or deletehashes, depending on the kind of album
+	key := ""
+	v := url.Values{}
+	for _, img := range images {
+		k := "ids[]"
+		if _, ok := img.(DeleteHash); ok {
+			k = "deletehashes[]"
+		}
+		if key != "" && key != k {
+			return -1, fmt.Errorf("Images of album %v mix IDs and deletehashes", id)
+		}
+		key = k
+		if refString(img) == "" {
+			return -1, fmt.Errorf("Empty image ID given for album %v", id)
+		}
+		v.Add(key, refString(img))
+	}
+
+	_, status, err := client.send(ctx, "POST", "album/"+id+"/"+action, v, nil)
+	if err != nil {
+		return status, fmt.Errorf("Problem changing images of album %v - %w", id, err)
+	}
+	return status, nil
+}
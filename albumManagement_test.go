@@ -0,0 +1,190 @@
+package imgur
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAlbum(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "POST", r.Method)
+		require.Equal(t, "/3/album", r.URL.Path)
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "Cats", r.PostForm.Get("title"))
+		require.Equal(t, "hidden", r.PostForm.Get("privacy"))
+		require.Equal(t, "grid", r.PostForm.Get("layout"))
+		require.Equal(t, []string{"a", "b"}, r.PostForm["deletehashes[]"])
+		require.NotContains(t, r.PostForm, "description")
+
+		w.Header().Set("X-RateLimit-ClientRemaining", "5")
+		fmt.Fprint(w, `{"data":{"id":"VZQXk","deletehash":"hash"},"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	album, status, err := client.CreateAlbum(context.Background(), AlbumOptions{
+		Title:        "Cats",
+		Privacy:      PrivacyHidden,
+		Layout:       LayoutGrid,
+		DeleteHashes: DeleteHashes("a", "b"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Equal(t, AlbumID("VZQXk"), album.ID)
+	require.Equal(t, DeleteHash("hash"), album.Deletehash)
+	require.Equal(t, int64(5), album.Limit.ClientRemaining)
+}
+
+func TestUpdateAlbum(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "PUT", r.Method)
+		require.Equal(t, "/3/album/hash", r.URL.Path)
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "New description", r.PostForm.Get("description"))
+		require.Equal(t, "CJCA0gW", r.PostForm.Get("cover"))
+		fmt.Fprint(w, `{"data":true,"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	status, err := client.UpdateAlbum(context.Background(), DeleteHash("hash"), AlbumOptions{Description: "New description", Cover: "CJCA0gW"})
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+
+	_, err = client.UpdateAlbum(context.Background(), DeleteHash(""), AlbumOptions{})
+	require.Error(t, err)
+}
+
+func TestSetAlbumCover(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "PUT", r.Method)
+		require.Equal(t, "/3/album/VZQXk", r.URL.Path)
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "CJCA0gW", r.PostForm.Get("cover"))
+		require.Len(t, r.PostForm, 1)
+		fmt.Fprint(w, `{"data":true,"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	status, err := client.SetAlbumCover(context.Background(), AlbumID("VZQXk"), ImageID("CJCA0gW"))
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+
+	_, err = client.SetAlbumCover(context.Background(), AlbumID("VZQXk"), ImageID(""))
+	require.Error(t, err)
+}
+
+func TestDeleteAlbum(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "DELETE", r.Method)
+		if r.URL.Path != "/3/album/hash" {
+			w.WriteHeader(404)
+			fmt.Fprint(w, `{"data":{"error":"Unable to find an album with the id, missing","request":"\/3\/album\/missing","method":"DELETE"},"success":false,"status":404}`)
+			return
+		}
+		fmt.Fprint(w, `{"data":true,"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	status, err := client.DeleteAlbum(context.Background(), DeleteHash("hash"))
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+
+	status, err = client.DeleteAlbum(context.Background(), DeleteHash("missing"))
+	require.True(t, errors.Is(err, ErrNotFound))
+	require.Equal(t, 404, status)
+}
+
+func TestAddAndRemoveAlbumImages(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "POST", r.Method)
+		require.Contains(t, []string{"/3/album/hash/add", "/3/album/hash/remove_images", "/3/album/VZQXk/add"}, r.URL.Path)
+		require.NoError(t, r.ParseForm())
+		key := "deletehashes[]"
+		if r.URL.Path == "/3/album/VZQXk/add" {
+			key = "ids[]"
+		}
+		require.Equal(t, []string{"a", "b"}, r.PostForm[key])
+		require.Len(t, r.PostForm, 1)
+		fmt.Fprint(w, `{"data":true,"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	status, err := client.AddImagesToAlbum(context.Background(), DeleteHash("hash"), DeleteHash("a"), DeleteHash("b"))
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+
+	status, err = client.RemoveImagesFromAlbum(context.Background(), DeleteHash("hash"), DeleteHash("a"), DeleteHash("b"))
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+
+	_, err = client.AddImagesToAlbum(context.Background(), DeleteHash("hash"))
+	require.Error(t, err)
+	_, err = client.AddImagesToAlbum(context.Background(), DeleteHash("hash"), ImageID("a"), DeleteHash("b"))
+	require.Error(t, err)
+
+	user, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	_, err = user.AddImagesToAlbum(context.Background(), AlbumID("VZQXk"), ImageID("a"), ImageID("b"))
+	require.NoError(t, err)
+}
+
+func TestAnonymousAlbum(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Client-ID testing", r.Header.Get("Authorization"))
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/3/album":
+			require.NoError(t, r.ParseForm())
+			require.Equal(t, []string{"a"}, r.PostForm["deletehashes[]"])
+			fmt.Fprint(w, `{"data":{"id":"VZQXk","deletehash":"hash"},"success":true,"status":200}`)
+		case r.Method == "POST" && r.URL.Path == "/3/album/hash/add":
+			require.NoError(t, r.ParseForm())
+			require.Equal(t, []string{"b", "c"}, r.PostForm["deletehashes[]"])
+			fmt.Fprint(w, `{"data":true,"success":true,"status":200}`)
+		case r.Method == "DELETE" && r.URL.Path == "/3/album/hash":
+			fmt.Fprint(w, `{"data":true,"success":true,"status":200}`)
+		default:
+			t.Fatalf("unexpected request %v %v", r.Method, r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	// the user token is not sent for anonymous albums
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	album, status, err := client.CreateAnonymousAlbum(context.Background(), AlbumOptions{DeleteHashes: DeleteHashes("a")})
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.True(t, album.Anonymous)
+	require.Equal(t, DeleteHash("hash"), album.Deletehash)
+	require.Equal(t, DeleteHash("hash"), album.Ref())
+
+	status, err = client.AddImagesToAnonymousAlbum(context.Background(), album, DeleteHashes("b", "c")...)
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+
+	status, err = client.DeleteAnonymousAlbum(context.Background(), album)
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+
+	_, status, err = client.CreateAnonymousAlbum(context.Background(), AlbumOptions{ImageIDs: []ImageID{"a"}})
+	require.Error(t, err)
+	require.Equal(t, -1, status)
+	_, err = client.DeleteAnonymousAlbum(context.Background(), &CreatedAlbum{ID: "VZQXk"})
+	require.Error(t, err)
+	_, err = client.AddImagesToAnonymousAlbum(context.Background(), album)
+	require.Error(t, err)
+}
+
+func TestCreatedAlbumRef(t *testing.T) {
+	album := &CreatedAlbum{ID: "VZQXk", Deletehash: "hash"}
+	require.Equal(t, AlbumID("VZQXk"), album.Ref())
+	album.Anonymous = true
+	require.Equal(t, DeleteHash("hash"), album.Ref())
+}
@@ -0,0 +1,258 @@
+package imgur
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// DefaultSyncManifest is the file name of the manifest SyncDirectory keeps in the synced directory
+const DefaultSyncManifest = ".imgur-sync.json"
+
+// SyncManifest records which album a directory is synced to and which image belongs to which file
+type SyncManifest struct {
+	AlbumID    AlbumID              `json:"album_id"`
+	DeleteHash DeleteHash           `json:"deletehash,omitempty"` // Deletehash of an anonymous album
+	Files      map[string]SyncEntry `json:"files"`                // Keyed by the slash separated path of the file
+	Obsolete   []SyncEntry          `json:"obsolete,omitempty"`   // Images of replaced and removed files that are still to be deleted
+}
+
+// SyncEntry is the uploaded image of a synced file
+type SyncEntry struct {
+	ImageID    ImageID    `json:"image_id"`
+	DeleteHash DeleteHash `json:"deletehash,omitempty"`
+	SHA256     string     `json:"sha256"` // Hex encoded hash of the uploaded content
+}
+
+// SyncOptions configures SyncDirectory
+type SyncOptions struct {
+	Manifest string       // Path of the manifest, defaults to DefaultSyncManifest in the synced directory
+	Album    AlbumOptions // Used to create the album on the first sync, the images are ignored
+	Bulk     BulkOptions  // Used for the uploads
+}
+
+// SyncResult lists the files changed by SyncDirectory
+type SyncResult struct {
+	AlbumID   AlbumID  // The synced album
+	Uploaded  []string // New files
+	Replaced  []string // Files whose content changed
+	Removed   []string // Files that no longer exist, their images are deleted
+	Unchanged int      // Number of files that were already in sync
+}
+
+// SyncDirectory makes an imgur album mirror the images and videos in dir. The first sync
+// creates the album, later syncs upload new files, replace the images of changed files and
+// delete the images of removed files. Files are identified by their path and the SHA-256
+// of their content as recorded in a manifest, so a sync without local changes sends no requests.
+// The album keeps the lexical order of the file paths. Files that failed to upload are
+// retried with the next sync, as are the deletions of images that are no longer synced.
+// returns what was changed, error
+func (client *Client) SyncDirectory(ctx context.Context, dir string, opts SyncOptions) (*SyncResult, error) {
+	manifestPath := opts.Manifest
+	if manifestPath == "" {
+		manifestPath = filepath.Join(dir, DefaultSyncManifest)
+	}
+	manifest, err := loadSyncManifest(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	fsys := os.DirFS(dir)
+	names, err := findUploadFiles(fsys)
+	if err != nil {
+		return nil, err
+	}
+	hashes := make(map[string]string, len(names))
+	for _, name := range names {
+		if hashes[name], err = hashFile(fsys, name); err != nil {
+			return nil, err
+		}
+	}
+
+	if manifest.AlbumID == "" {
+		opts.Album.ImageIDs, opts.Album.DeleteHashes = nil, nil
+		album, _, err := client.CreateAlbum(ctx, opts.Album)
+		if err != nil {
+			return nil, err
+		}
+		manifest.AlbumID, manifest.DeleteHash = album.ID, album.Deletehash
+		if err := manifest.save(manifestPath); err != nil {
+			return nil, err
+		}
+	}
+	result := &SyncResult{AlbumID: manifest.AlbumID}
+
+	// upload new and changed files first so a failed sync does not lose images
+	var pending []string
+	var sources []UploadSource
+	for _, name := range names {
+		entry, ok := manifest.Files[name]
+		switch {
+		case !ok:
+			result.Uploaded = append(result.Uploaded, name)
+		case entry.SHA256 != hashes[name]:
+			result.Replaced = append(result.Replaced, name)
+		default:
+			result.Unchanged++
+			continue
+		}
+		pending = append(pending, name)
+		sources = append(sources, fsUploadSource(fsys, name))
+	}
+
+	var uploadErr error
+	for i, res := range client.UploadMany(ctx, sources, opts.Bulk) {
+		name := pending[i]
+		if res.Err != nil {
+			if uploadErr == nil {
+				uploadErr = fmt.Errorf("Problem uploading %v - %w", name, res.Err)
+			}
+			continue
+		}
+		if old, ok := manifest.Files[name]; ok {
+			manifest.Obsolete = append(manifest.Obsolete, old)
+		}
+		manifest.Files[name] = SyncEntry{ImageID: res.Image.ID, DeleteHash: res.Image.Deletehash, SHA256: hashes[name]}
+	}
+
+	for name, entry := range manifest.Files {
+		if _, ok := hashes[name]; !ok {
+			result.Removed = append(result.Removed, name)
+			manifest.Obsolete = append(manifest.Obsolete, entry)
+			delete(manifest.Files, name)
+		}
+	}
+	sort.Strings(result.Removed)
+	if err := manifest.save(manifestPath); err != nil {
+		return nil, err
+	}
+
+	// the obsolete images stay in the manifest until they are deleted, so an interrupted sync
+	// deletes them with the next one
+	if len(pending) > 0 || len(manifest.Obsolete) > 0 {
+		if err := client.arrangeSyncedAlbum(ctx, manifest, names); err != nil {
+			return result, err
+		}
+	}
+	if len(manifest.Obsolete) > 0 {
+		err := client.deleteObsolete(ctx, manifest)
+		if serr := manifest.save(manifestPath); err == nil {
+			err = serr
+		}
+		if err != nil {
+			return result, err
+		}
+	}
+	return result, uploadErr
+}
+
+// deleteObsolete deletes the obsolete images of manifest and removes them from it.
+// It stops at the first image that could not be deleted, images that are already gone are removed.
+func (client *Client) deleteObsolete(ctx context.Context, manifest *SyncManifest) error {
+	for len(manifest.Obsolete) > 0 {
+		entry := manifest.Obsolete[0]
+		if _, _, err := client.DeleteImage(ctx, entry.ref()); err != nil && !errors.Is(err, ErrNotFound) {
+			return err
+		}
+		manifest.Obsolete = manifest.Obsolete[1:]
+	}
+	manifest.Obsolete = nil
+	return nil
+}
+
+// arrangeSyncedAlbum sets the images of the album to the synced files in the order of names
+func (client *Client) arrangeSyncedAlbum(ctx context.Context, manifest *SyncManifest, names []string) error {
+	var opts AlbumOptions
+	album := AlbumRef(manifest.AlbumID)
+	if !client.authenticated() {
+		album = manifest.DeleteHash
+	}
+	for _, name := range names {
+		entry, ok := manifest.Files[name]
+		if !ok {
+			continue
+		}
+		if client.authenticated() {
+			opts.ImageIDs = append(opts.ImageIDs, entry.ImageID)
+		} else {
+			opts.DeleteHashes = append(opts.DeleteHashes, entry.DeleteHash)
+		}
+	}
+	if len(opts.ImageIDs) == 0 && len(opts.DeleteHashes) == 0 {
+		// imgur ignores an update without images, the deleted images vanish from the album anyway
+		return nil
+	}
+	_, err := client.UpdateAlbum(ctx, album, opts)
+	return err
+}
+
+// ref returns how the image of e can be deleted, the deletehash works for anonymous and account images
+func (e SyncEntry) ref() ImageRef {
+	if e.DeleteHash != "" {
+		return e.DeleteHash
+	}
+	return e.ImageID
+}
+
+func loadSyncManifest(name string) (*SyncManifest, error) {
+	manifest := &SyncManifest{Files: map[string]SyncEntry{}}
+	data, err := os.ReadFile(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return manifest, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Problem reading sync manifest %v - %w", name, err)
+	}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("Problem decoding sync manifest %v - %w", name, err)
+	}
+	if manifest.Files == nil {
+		manifest.Files = map[string]SyncEntry{}
+	}
+	return manifest, nil
+}
+
+// save writes the manifest to a temporary file first, so an interrupted sync keeps the old manifest
+func (m *SyncManifest) save(name string) error {
+	data, err := json.MarshalIndent(m, "", "\t")
+	if err != nil {
+		return fmt.Errorf("Problem encoding sync manifest - %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(name), filepath.Base(name)+".*")
+	if err != nil {
+		return fmt.Errorf("Problem writing sync manifest %v - %w", name, err)
+	}
+	_, err = tmp.Write(data)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), name)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("Problem writing sync manifest %v - %w", name, err)
+	}
+	return nil
+}
+
+func hashFile(fsys fs.FS, name string) (string, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return "", fmt.Errorf("Could not open file %v - Error: %w", name, err)
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("Could not read file %v - Error: %w", name, err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,159 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testSyncServer struct {
+	mu       sync.Mutex
+	requests []string
+	album    []string
+	failPuts int // number of album updates that fail
+}
+
+func (s *testSyncServer) handler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/3/image":
+			require.NoError(t, r.ParseMultipartForm(1<<20))
+			data := formFile(t, r, "image")
+			fmt.Fprintf(w, `{"data":{"id":"%v","deletehash":"hash-%v"},"success":true,"status":200}`, data, data)
+		case r.Method == "POST" && r.URL.Path == "/3/album":
+			fmt.Fprint(w, `{"data":{"id":"VZQXk","deletehash":"albumhash"},"success":true,"status":200}`)
+		case r.Method == "PUT" && r.URL.Path == "/3/album/VZQXk" && s.failPuts > 0:
+			s.failPuts--
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"data":{"error":"Update failed"},"success":false,"status":400}`)
+		case r.Method == "PUT" && r.URL.Path == "/3/album/VZQXk":
+			require.NoError(t, r.ParseForm())
+			s.album = r.PostForm["ids[]"]
+			fmt.Fprint(w, `{"data":true,"success":true,"status":200}`)
+		case r.Method == "DELETE" && strings.HasPrefix(r.URL.Path, "/3/image/"):
+			fmt.Fprint(w, `{"data":true,"success":true,"status":200}`)
+		default:
+			t.Errorf("Unexpected request %v %v", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func (s *testSyncServer) reset() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	requests := s.requests
+	s.requests = nil
+	return requests
+}
+
+func TestSyncDirectory(t *testing.T) {
+	srv := &testSyncServer{}
+	httpC, server := testHTTPClientHandler(srv.handler(t))
+	defer server.Close()
+
+	dir := t.TempDir()
+	write := func(name, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+	write("a.png", "a1")
+	write("sub/b.jpg", "b1")
+	write("readme.txt", "ignored")
+
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	result, err := client.SyncDirectory(context.Background(), dir, SyncOptions{Album: AlbumOptions{Title: "Sync"}})
+	require.NoError(t, err)
+	require.Equal(t, AlbumID("VZQXk"), result.AlbumID)
+	require.Equal(t, []string{"a.png", "sub/b.jpg"}, result.Uploaded)
+	require.Equal(t, []string{"a1", "b1"}, srv.album)
+	require.Len(t, srv.reset(), 4)
+
+	// nothing changed, nothing is sent
+	result, err = client.SyncDirectory(context.Background(), dir, SyncOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 2, result.Unchanged)
+	require.Empty(t, srv.reset())
+
+	write("a.png", "a2")
+	write("c.gif", "c1")
+	require.NoError(t, os.Remove(filepath.Join(dir, "sub/b.jpg")))
+	result, err = client.SyncDirectory(context.Background(), dir, SyncOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"c.gif"}, result.Uploaded)
+	require.Equal(t, []string{"a.png"}, result.Replaced)
+	require.Equal(t, []string{"sub/b.jpg"}, result.Removed)
+	require.Equal(t, []string{"a2", "c1"}, srv.album)
+	requests := srv.reset()
+	require.Contains(t, requests, "DELETE /3/image/hash-a1")
+	require.Contains(t, requests, "DELETE /3/image/hash-b1")
+
+	manifest, err := loadSyncManifest(filepath.Join(dir, DefaultSyncManifest))
+	require.NoError(t, err)
+	require.Equal(t, DeleteHash("albumhash"), manifest.DeleteHash)
+	require.Equal(t, ImageID("a2"), manifest.Files["a.png"].ImageID)
+	require.Len(t, manifest.Files, 2)
+}
+
+func TestSyncDirectoryResumesDeletions(t *testing.T) {
+	srv := &testSyncServer{}
+	httpC, server := testHTTPClientHandler(srv.handler(t))
+	defer server.Close()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.png"), []byte("a1"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.png"), []byte("b1"), 0644))
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	_, err := client.SyncDirectory(context.Background(), dir, SyncOptions{})
+	require.NoError(t, err)
+	srv.reset()
+
+	// the album update fails, the old images must not be forgotten
+	srv.failPuts = 1
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.png"), []byte("a2"), 0644))
+	require.NoError(t, os.Remove(filepath.Join(dir, "b.png")))
+	_, err = client.SyncDirectory(context.Background(), dir, SyncOptions{})
+	require.Error(t, err)
+	require.NotContains(t, srv.reset(), "DELETE /3/image/hash-a1")
+	manifest, err := loadSyncManifest(filepath.Join(dir, DefaultSyncManifest))
+	require.NoError(t, err)
+	require.Equal(t, []SyncEntry{
+		{ImageID: "a1", DeleteHash: "hash-a1", SHA256: manifest.Obsolete[0].SHA256},
+		{ImageID: "b1", DeleteHash: "hash-b1", SHA256: manifest.Obsolete[1].SHA256},
+	}, manifest.Obsolete)
+
+	// the next sync has no local changes, but cleans up
+	result, err := client.SyncDirectory(context.Background(), dir, SyncOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Unchanged)
+	require.Equal(t, []string{"a2"}, srv.album)
+	require.ElementsMatch(t, []string{"PUT /3/album/VZQXk", "DELETE /3/image/hash-a1", "DELETE /3/image/hash-b1"}, srv.reset())
+	manifest, err = loadSyncManifest(filepath.Join(dir, DefaultSyncManifest))
+	require.NoError(t, err)
+	require.Empty(t, manifest.Obsolete)
+
+	// and afterwards nothing is sent
+	_, err = client.SyncDirectory(context.Background(), dir, SyncOptions{})
+	require.NoError(t, err)
+	require.Empty(t, srv.reset())
+}
+
+func TestSyncManifestErrors(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "manifest.json")
+	manifest, err := loadSyncManifest(name)
+	require.NoError(t, err)
+	require.Empty(t, manifest.Files)
+
+	require.NoError(t, os.WriteFile(name, []byte("{broken"), 0644))
+	_, err = loadSyncManifest(name)
+	require.Error(t, err)
+}
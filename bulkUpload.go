@@ -0,0 +1,85 @@
+package imgur
+
+import (
+	"context"
+	"sync"
+)
+
+// BulkOptions configures UploadMany
+type BulkOptions struct {
+	Concurrency int            // Number of parallel uploads, defaults to 4
+	Retry       *RetryPolicy   // Retry policy of each upload, defaults to DefaultRetryPolicy
+	Options     []UploadOption // Applied to every upload, e.g. WithAlbum
+}
+
+// UploadResult is the outcome of one upload of UploadMany or UploadBuilder.Do
+type UploadResult struct {
+	Source UploadSource // The uploaded source
+	Image  *ImageInfo   // The uploaded image, nil if the upload failed
+	Status int          // Status code of the upload
+	Err    error        // Why the upload failed
+}
+
+const defaultBulkConcurrency = 4
+
+// UploadMany uploads all sources with a bounded number of parallel uploads. Failed uploads are
+// retried if their source can be read again, which is not the case for ReaderSource.
+// Once the user credits are used up, uploads wait for the reset unless the client is set up
+// to reject them with WithThrottle. Uploads not started before ctx is done fail with its error.
+// returns one result per source in the order of sources
+func (client *Client) UploadMany(ctx context.Context, sources []UploadSource, opts BulkOptions) []UploadResult {
+	policy := DefaultRetryPolicy
+	if opts.Retry != nil {
+		policy = *opts.Retry
+	}
+	ctx = client.bulkContext(ContextWithRetryPolicy(ctx, policy))
+
+	results := make([]UploadResult, len(sources))
+	runBulk(ctx, len(sources), opts.Concurrency, func(i int) {
+		img, status, err := client.Upload(ctx, sources[i], opts.Options...)
+		results[i] = UploadResult{Source: sources[i], Image: img, Status: status, Err: err}
+	}, func(i int) {
+		results[i] = UploadResult{Source: sources[i], Status: -1, Err: ctx.Err()}
+	})
+	return results
+}
+
+// bulkContext makes the requests bound to ctx wait for credits, unless the client
+// rejects them with WithThrottle
+func (client *Client) bulkContext(ctx context.Context) context.Context {
+	if client.throttleModeFor(ctx) == ThrottleOff {
+		ctx = contextWithThrottleMode(ctx, ThrottleDelay)
+	}
+	return ctx
+}
+
+// runBulk calls run for the indices 0 to n-1 on a bounded number of workers, which
+// defaults to defaultBulkConcurrency. Indices not started before ctx is done are passed
+// to canceled instead.
+func runBulk(ctx context.Context, n int, workers int, run func(i int), canceled func(i int)) {
+	if workers <= 0 {
+		workers = defaultBulkConcurrency
+	}
+
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < n; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				run(i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		select {
+		case indices <- i:
+		case <-ctx.Done():
+			canceled(i)
+		}
+	}
+	close(indices)
+	wg.Wait()
+}
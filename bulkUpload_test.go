@@ -0,0 +1,94 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadMany(t *testing.T) {
+	var requests, running, maxRunning int32
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+				break
+			}
+		}
+
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		require.Equal(t, "VZQXk", r.FormValue("album"))
+		data := formFile(t, r, "image")
+		// the first attempt of "retry" fails
+		if data == "retry" && atomic.AddInt32(&requests, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if data == "broken" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"data":{"error":"Bad image"},"success":false,"status":400}`)
+			return
+		}
+		fmt.Fprintf(w, `{"data":{"id":"%v"},"success":true,"status":200}`, data)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	sources := []UploadSource{
+		BytesSource([]byte("a")),
+		BytesSource([]byte("retry")),
+		BytesSource([]byte("broken")),
+		BytesSource([]byte("b")),
+	}
+	retry := RetryPolicy{MaxAttempts: 2}
+	results := client.UploadMany(context.Background(), sources, BulkOptions{
+		Concurrency: 2,
+		Retry:       &retry,
+		Options:     []UploadOption{WithAlbum(AlbumID("VZQXk"))},
+	})
+	require.Len(t, results, 4)
+	require.NoError(t, results[0].Err)
+	require.Equal(t, ImageID("a"), results[0].Image.ID)
+	require.NoError(t, results[1].Err)
+	require.Equal(t, ImageID("retry"), results[1].Image.ID)
+	require.Error(t, results[2].Err)
+	require.Nil(t, results[2].Image)
+	require.Equal(t, 400, results[2].Status)
+	require.Equal(t, ImageID("b"), results[3].Image.ID)
+	require.LessOrEqual(t, atomic.LoadInt32(&maxRunning), int32(2))
+}
+
+func TestUploadManyCanceled(t *testing.T) {
+	client, _ := NewClient(new(http.Client), "testing", "")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results := client.UploadMany(ctx, []UploadSource{BytesSource([]byte("a")), BytesSource([]byte("b"))}, BulkOptions{})
+	for _, result := range results {
+		require.Error(t, result.Err)
+	}
+}
+
+func TestUploadManyWaitsForCredits(t *testing.T) {
+	var requests int
+	httpC, closeServer := testHTTPClientCredits(0, 12000, time.Now().Add(time.Hour), &requests)
+	defer closeServer()
+
+	client, _ := NewClient(httpC, "testing", "")
+	_, _, err := client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+
+	// without user credits the upload waits for the reset instead of being sent
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	results := client.UploadMany(ctx, []UploadSource{BytesSource([]byte("a"))}, BulkOptions{})
+	require.ErrorIs(t, results[0].Err, context.DeadlineExceeded)
+	require.Equal(t, 1, requests)
+}
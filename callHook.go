@@ -0,0 +1,191 @@
+package imgur
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// CallInfo describes a finished request of the client to the imgur API
+type CallInfo struct {
+	Method     string        // HTTP method of the request
+	Endpoint   string        // The endpoint with its parameters as placeholders, like "image/{id}"
+	URL        string        // URL of the request
+	StatusCode int           // HTTP status of the last response, 0 if none was received
+	Attempts   int           // Number of times the request was sent, more than 1 if it was retried
+	RateLimit  *RateLimit    // Rate limits reported with the last response, nil if there were none
+	Duration   time.Duration // Time the call took, including retries and waiting for credits
+	BytesSent  int64         // Size of the request bodies sent, including retries
+	Err        error         // Error sending the request, nil if imgur answered with an error status
+}
+
+// CallHook observes all requests of a client to the imgur API, e.g. to trace them.
+// Downloads of files are not API requests and are not observed.
+type CallHook interface {
+	// StartCall is called before a request is sent. The returned context is used for
+	// the request and passed to EndCall, so it can carry e.g. a tracing span.
+	StartCall(ctx context.Context, method string, endpoint string) context.Context
+	// EndCall is called once the request succeeded or failed for good
+	EndCall(ctx context.Context, info CallInfo)
+}
+
+// WithCallHook informs hook about every API request of the client. Hooks are called
+// in the order they are given.
+func WithCallHook(hook CallHook) ClientOption {
+	return func(c *Client) {
+		if hook != nil {
+			c.callHooks = append(c.callHooks, hook)
+		}
+	}
+}
+
+// observe sends req like retry and informs the call hooks about it
+func (client *Client) observe(req *http.Request) (*http.Response, error) {
+	if len(client.callHooks) == 0 {
+		return client.retry(req, new(int))
+	}
+
+	start := time.Now()
+	URL := req.URL.String()
+	endpoint := client.endpoint(URL)
+	contexts := make([]context.Context, len(client.callHooks))
+	ctx := req.Context()
+	for i, hook := range client.callHooks {
+		ctx = hook.StartCall(ctx, req.Method, endpoint)
+		contexts[i] = ctx
+	}
+
+	req = req.WithContext(ctx)
+	var sent int64
+	if req.Body != nil && req.Body != http.NoBody {
+		req.Body = &countingReader{ReadCloser: req.Body, n: &sent}
+		if getBody := req.GetBody; getBody != nil {
+			req.GetBody = func() (io.ReadCloser, error) {
+				body, err := getBody()
+				if err != nil {
+					return nil, err
+				}
+				return &countingReader{ReadCloser: body, n: &sent}, nil
+			}
+		}
+	}
+
+	attempts := 0
+	res, err := client.retry(req, &attempts)
+
+	info := CallInfo{
+		Method:    req.Method,
+		Endpoint:  endpoint,
+		URL:       URL,
+		Attempts:  attempts,
+		Duration:  time.Since(start),
+		BytesSent: atomic.LoadInt64(&sent),
+		Err:       err,
+	}
+	if res != nil {
+		info.StatusCode = res.StatusCode
+		if hasRateLimits(res.Header) {
+			info.RateLimit, _ = extractRateLimits(res.Header)
+		}
+	}
+	for i, hook := range client.callHooks {
+		hook.EndCall(contexts[i], info)
+	}
+	return res, err
+}
+
+// countingReader adds the number of bytes read to n. The transport may read the
+// body in another goroutine, so n is updated atomically.
+type countingReader struct {
+	io.ReadCloser
+	n *int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	atomic.AddInt64(r.n, int64(n))
+	return n, err
+}
+
+// endpoints are the templates of the API paths used by the client, parameters are in braces
+var endpoints = splitEndpoints(
+	"image", "upload", "image/{id}", "image/{id}/favorite",
+	"album", "album/{id}", "album/{id}/images", "album/{id}/add", "album/{id}/remove_images", "album/{id}/favorite",
+	"gallery/image/{id}", "gallery/album/{id}", "gallery/{id}", "gallery/{id}/votes", "gallery/{id}/vote/{vote}",
+	"gallery/{id}/comments/{sort}", "gallery/{id}/comments/count", "gallery/{id}/vote/tag/{tag}/{vote}",
+	"gallery/tags/{id}", "gallery/tag_info/{tag}", "gallery/t/{tag}/{sort}/{page}",
+	"gallery/{section}/{sort}/{window}/{page}", "gallery/search/{sort}/{window}/{page}",
+	"gallery/r/{subreddit}/{sort}/{window}/{page}", "gallery/r/{subreddit}/{id}",
+	"comment", "comment/{id}", "comment/{id}/replies", "comment/{id}/vote/{vote}", "comment/{id}/report",
+	"account/{username}", "account/{username}/settings", "account/{username}/verifyemail", "account/{username}/gallery_profile",
+	"account/{username}/avatar", "account/{username}/available_avatars", "account/{username}/block",
+	"account/{username}/images/{page}", "account/{username}/images/ids/{page}", "account/{username}/images/count",
+	"account/{username}/albums/{page}", "account/{username}/albums/ids/{page}", "account/{username}/albums/count",
+	"account/{username}/comments/{sort}/{page}", "account/{username}/comments/ids/{sort}/{page}", "account/{username}/comments/count",
+	"account/{username}/favorites/{page}/{sort}", "account/{username}/gallery_favorites/{page}/{sort}",
+	"account/{username}/submissions/{page}",
+	"conversations", "conversations/{id}", "conversations/{id}/{page}", "conversations/block/{username}", "conversations/report/{username}",
+	"notification", "credits",
+	"/account/v1/{username}/block", "/oauth2/token",
+)
+
+func splitEndpoints(templates ...string) [][]string {
+	split := make([][]string, len(templates))
+	for i, t := range templates {
+		split[i] = strings.Split(strings.TrimPrefix(t, "/"), "/")
+		if strings.HasPrefix(t, "/") {
+			split[i][0] = "/" + split[i][0]
+		}
+	}
+	return split
+}
+
+// endpoint returns the template of the API path of URL, which has the parameters as
+// placeholders. Endpoints outside of the versioned API start with "/". Unknown
+// endpoints are named after their first path segment.
+func (client *Client) endpoint(URL string) string {
+	if i := strings.IndexAny(URL, "?#"); i >= 0 {
+		URL = URL[:i]
+	}
+	path := strings.TrimPrefix(URL, client.createAPIURL(""))
+	if path == URL {
+		path = "/" + strings.TrimPrefix(URL, client.createRootURL(""))
+	}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if strings.HasPrefix(path, "/") {
+		segments[0] = "/" + segments[0]
+	}
+
+	best, bestScore := []string(nil), -1
+	for _, template := range endpoints {
+		if score := matchEndpoint(template, segments); score > bestScore {
+			best, bestScore = template, score
+		}
+	}
+	if best == nil {
+		return segments[0]
+	}
+	return strings.Join(best, "/")
+}
+
+// matchEndpoint returns the number of literal segments of template matching segments,
+// -1 if segments do not match the template
+func matchEndpoint(template []string, segments []string) int {
+	if len(template) != len(segments) {
+		return -1
+	}
+	score := 0
+	for i, t := range template {
+		switch {
+		case strings.HasPrefix(t, "{") && segments[i] != "":
+		case t == segments[i]:
+			score++
+		default:
+			return -1
+		}
+	}
+	return score
+}
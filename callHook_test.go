@@ -0,0 +1,123 @@
+package imgur
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type ctxKey string
+
+type recordingHook struct {
+	name    string
+	started []string
+	infos   []CallInfo
+}
+
+func (h *recordingHook) StartCall(ctx context.Context, method string, endpoint string) context.Context {
+	h.started = append(h.started, method+" "+endpoint)
+	return context.WithValue(ctx, ctxKey(h.name), true)
+}
+
+func (h *recordingHook) EndCall(ctx context.Context, info CallInfo) {
+	if ctx.Value(ctxKey(h.name)) == nil {
+		panic("EndCall got another context than StartCall returned")
+	}
+	h.infos = append(h.infos, info)
+}
+
+func TestCallHook(t *testing.T) {
+	requests := 0
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(503)
+			return
+		}
+		w.Header().Set("X-RateLimit-UserLimit", "10")
+		w.Header().Set("X-RateLimit-UserRemaining", "2")
+		w.Header().Set("X-RateLimit-ClientLimit", "40")
+		w.Header().Set("X-RateLimit-ClientRemaining", "5")
+		w.Write([]byte(`{"data":{"id":"ClF8rLe"},"success":true,"status":200}`))
+	})
+	defer server.Close()
+
+	first, second := &recordingHook{name: "first"}, &recordingHook{name: "second"}
+	hooked := func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			require.NotNil(t, r.Context().Value(ctxKey("first")))
+			require.NotNil(t, r.Context().Value(ctxKey("second")))
+			return next.RoundTrip(r)
+		})
+	}
+	client, err := New("id", WithHTTPClient(httpC), WithTransportMiddleware(hooked),
+		WithCallHook(first), WithCallHook(nil), WithCallHook(second),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}))
+	require.NoError(t, err)
+
+	_, _, err = client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+	require.Equal(t, []string{"GET image/{id}"}, first.started)
+	require.Equal(t, first.started, second.started)
+	require.Len(t, first.infos, 1)
+
+	info := first.infos[0]
+	require.Equal(t, "GET", info.Method)
+	require.Equal(t, "image/{id}", info.Endpoint)
+	require.Equal(t, "https://api.imgur.com/3/image/ClF8rLe", info.URL)
+	require.Equal(t, 200, info.StatusCode)
+	require.Equal(t, 2, info.Attempts)
+	require.Equal(t, int64(2), info.RateLimit.UserRemaining)
+	require.Equal(t, int64(5), info.RateLimit.ClientRemaining)
+	require.NoError(t, info.Err)
+	require.True(t, info.Duration > 0)
+}
+
+func TestCallHookError(t *testing.T) {
+	hook := &recordingHook{name: "hook"}
+	client, err := New("id", WithHTTPClient(&http.Client{Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return nil, context.DeadlineExceeded
+	})}), WithCallHook(hook), WithRetryPolicy(NoRetry))
+	require.NoError(t, err)
+
+	_, _, err = client.GetCredits(context.Background())
+	require.Error(t, err)
+	require.Len(t, hook.infos, 1)
+	require.Equal(t, 0, hook.infos[0].StatusCode)
+	require.Equal(t, 1, hook.infos[0].Attempts)
+	require.Nil(t, hook.infos[0].RateLimit)
+	require.ErrorIs(t, hook.infos[0].Err, context.DeadlineExceeded)
+}
+
+func TestEndpoint(t *testing.T) {
+	client, err := New("id")
+	require.NoError(t, err)
+	proxied, err := New("id", WithBaseURL("http://localhost:8080/mock/3"))
+	require.NoError(t, err)
+
+	tests := map[string]string{
+		"https://api.imgur.com/3/image":                                  "image",
+		"https://api.imgur.com/3/image/ClF8rLe":                          "image/{id}",
+		"https://api.imgur.com/3/album/VZQXk/remove_images":              "album/{id}/remove_images",
+		"https://api.imgur.com/3/gallery/image/ClF8rLe":                  "gallery/image/{id}",
+		"https://api.imgur.com/3/gallery/hot/viral/day/0?showViral=true": "gallery/{section}/{sort}/{window}/{page}",
+		"https://api.imgur.com/3/gallery/search/time/all/1?q=cats":       "gallery/search/{sort}/{window}/{page}",
+		"https://api.imgur.com/3/gallery/ClF8rLe/comments/count":         "gallery/{id}/comments/count",
+		"https://api.imgur.com/3/gallery/ClF8rLe/comments/best":          "gallery/{id}/comments/{sort}",
+		"https://api.imgur.com/3/account/me/images/count":                "account/{username}/images/count",
+		"https://api.imgur.com/3/account/me/images/2":                    "account/{username}/images/{page}",
+		"https://api.imgur.com/3/conversations/block/troll":              "conversations/block/{username}",
+		"https://api.imgur.com/3/conversations/42/1":                     "conversations/{id}/{page}",
+		"https://api.imgur.com/account/v1/troll/block":                   "/account/v1/{username}/block",
+		"https://api.imgur.com/oauth2/token":                             "/oauth2/token",
+		"https://api.imgur.com/3/topics/defaults":                        "topics",
+	}
+	for URL, endpoint := range tests {
+		require.Equal(t, endpoint, client.endpoint(URL), URL)
+	}
+	require.Equal(t, "album/{id}/images", proxied.endpoint("http://localhost:8080/mock/3/album/VZQXk/images"))
+	require.Equal(t, "/oauth2/token", proxied.endpoint("http://localhost:8080/mock/oauth2/token"))
+}
@@ -0,0 +1,114 @@
+package imgur
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// CircuitBreaker describes when the client stops sending requests during an outage of imgur.
+// After FailureThreshold consecutive requests failed with a 5xx status or a transport error,
+// all requests fail with ErrCircuitOpen for CoolDown. Then a single request is let through
+// to probe if imgur recovered, it closes the circuit if it succeeds and opens it again otherwise.
+// The zero value disables the circuit breaker.
+type CircuitBreaker struct {
+	FailureThreshold int           // Consecutive failures opening the circuit, values below 1 disable the circuit breaker
+	CoolDown         time.Duration // How long requests fail fast before a probe is sent
+}
+
+// DefaultCircuitBreaker is a reasonable circuit breaker for most applications.
+var DefaultCircuitBreaker = CircuitBreaker{
+	FailureThreshold: 5,
+	CoolDown:         30 * time.Second,
+}
+
+// WithCircuitBreaker enables a circuit breaker for all requests of the client. Every
+// attempt of a retried request counts, so retries stop as soon as the circuit opens.
+func WithCircuitBreaker(cb CircuitBreaker) ClientOption {
+	return func(c *Client) {
+		if cb.FailureThreshold < 1 {
+			c.breaker = nil
+			return
+		}
+		c.breaker = &circuitBreaker{config: cb}
+	}
+}
+
+// circuitBreaker is the state of the CircuitBreaker of a client
+type circuitBreaker struct {
+	config CircuitBreaker
+
+	mu        sync.Mutex
+	failures  int       // consecutive failures
+	openUntil time.Time // zero while the circuit is closed
+	probing   bool      // if the probe of a half open circuit is in flight
+}
+
+// allow returns ErrCircuitOpen if a request must not be sent. Once the cool down is
+// over, the first request is allowed as probe, which is reported by the returned bool.
+// An allowed request has to be followed by done or release, passing if it is the probe,
+// so requests that were sent before the circuit opened do not end the probe.
+func (b *circuitBreaker) allow() (bool, error) {
+	if b == nil {
+		return false, nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.openUntil.IsZero() {
+		return false, nil
+	}
+	if b.probing || time.Now().Before(b.openUntil) {
+		return false, fmt.Errorf("%w after %v failed requests, retrying after %v", ErrCircuitOpen, b.failures, b.openUntil)
+	}
+	b.probing = true
+	return true, nil
+}
+
+// done records the outcome of a request. It returns true and the number of consecutive
+// failures if the failure opened the circuit.
+func (b *circuitBreaker) done(probe bool, failed bool) (bool, int) {
+	if b == nil {
+		return false, 0
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if probe {
+		b.probing = false
+	}
+	if !failed {
+		b.failures = 0
+		b.openUntil = time.Time{}
+		return false, 0
+	}
+	b.failures++
+	if probe || (b.openUntil.IsZero() && b.failures >= b.config.FailureThreshold) {
+		b.openUntil = time.Now().Add(b.config.CoolDown)
+		return true, b.failures
+	}
+	return false, b.failures
+}
+
+// release ends a request whose outcome says nothing about imgur, e.g. because it was canceled
+func (b *circuitBreaker) release(probe bool) {
+	if b == nil || !probe {
+		return
+	}
+	b.mu.Lock()
+	b.probing = false
+	b.mu.Unlock()
+}
+
+// recordOutcome passes the outcome of sending req to the circuit breaker of the client,
+// probe is set if req was allowed as probe
+func (client *Client) recordOutcome(req *http.Request, res *http.Response, err error, probe bool) {
+	if err != nil && req.Context().Err() != nil {
+		client.breaker.release(probe)
+		return
+	}
+	if opened, failures := client.breaker.done(probe, err != nil || res.StatusCode >= 500); opened {
+		client.Log.Infof("Circuit breaker opened after %v failed requests, failing requests for %v", failures, client.breaker.config.CoolDown)
+	}
+}
@@ -0,0 +1,133 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	var requests int
+	status := http.StatusServiceUnavailable
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"id":"ClF8rLe"},"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithRetryPolicy(testRetryPolicy),
+		WithCircuitBreaker(CircuitBreaker{FailureThreshold: 4, CoolDown: 50 * time.Millisecond}))
+
+	// the first request fails 3 times, the first attempt of the second opens the circuit
+	// and its retry fails fast
+	_, _, err := client.GetImageInfo("ClF8rLe")
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrCircuitOpen)
+	_, _, err = client.GetImageInfo("ClF8rLe")
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	require.Equal(t, 4, requests)
+	_, _, err = client.GetImageInfo("ClF8rLe")
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	require.Equal(t, 4, requests)
+
+	// a failed probe opens the circuit again
+	time.Sleep(60 * time.Millisecond)
+	_, _, err = client.GetImageInfoWithContext(ContextWithRetryPolicy(context.Background(), NoRetry), "ClF8rLe")
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrCircuitOpen)
+	require.Equal(t, 5, requests)
+	_, _, err = client.GetImageInfo("ClF8rLe")
+	require.ErrorIs(t, err, ErrCircuitOpen)
+
+	// a successful probe closes it
+	time.Sleep(60 * time.Millisecond)
+	status = http.StatusOK
+	_, _, err = client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+	_, _, err = client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+	require.Equal(t, 7, requests)
+}
+
+func TestCircuitBreakerIgnoresClientErrors(t *testing.T) {
+	var requests int
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNotFound)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithCircuitBreaker(CircuitBreaker{FailureThreshold: 1, CoolDown: time.Hour}))
+	for i := 0; i < 3; i++ {
+		_, _, err := client.GetImageInfo("ClF8rLe")
+		require.ErrorIs(t, err, ErrNotFound)
+	}
+	require.Equal(t, 3, requests)
+}
+
+func TestCircuitBreakerProbe(t *testing.T) {
+	b := &circuitBreaker{config: CircuitBreaker{FailureThreshold: 1, CoolDown: time.Millisecond}}
+	probe, err := b.allow()
+	require.NoError(t, err)
+	require.False(t, probe)
+	opened, failures := b.done(probe, true)
+	require.True(t, opened)
+	require.Equal(t, 1, failures)
+
+	time.Sleep(2 * time.Millisecond)
+	probe, err = b.allow()
+	require.NoError(t, err)
+	require.True(t, probe)
+	// only one probe at a time
+	_, err = b.allow()
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	// a canceled probe lets the next request probe
+	b.release(probe)
+	probe, err = b.allow()
+	require.NoError(t, err)
+	require.True(t, probe)
+	opened, _ = b.done(probe, false)
+	require.False(t, opened)
+	probe, err = b.allow()
+	require.NoError(t, err)
+	require.False(t, probe)
+
+	var disabled *circuitBreaker
+	_, err = disabled.allow()
+	require.NoError(t, err)
+}
+
+func TestCircuitBreakerProbeHeldByOneRequest(t *testing.T) {
+	b := &circuitBreaker{config: CircuitBreaker{FailureThreshold: 1, CoolDown: time.Millisecond}}
+	// a request is sent while the circuit is closed
+	inFlight, err := b.allow()
+	require.NoError(t, err)
+	failed, _ := b.allow()
+	b.done(failed, true)
+
+	time.Sleep(2 * time.Millisecond)
+	probe, err := b.allow()
+	require.NoError(t, err)
+	require.True(t, probe)
+
+	// the request sent before the circuit opened ends, the probe is still in flight
+	b.done(inFlight, true)
+	_, err = b.allow()
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	time.Sleep(2 * time.Millisecond)
+	b.release(inFlight)
+	_, err = b.allow()
+	require.ErrorIs(t, err, ErrCircuitOpen)
+
+	b.done(probe, false)
+	_, err = b.allow()
+	require.NoError(t, err)
+}
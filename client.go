@@ -3,8 +3,10 @@ package imgur
 import (
 	"fmt"
 	"net/http"
-
-	"github.com/koffeinsource/go-klogger"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
 )
 
 // ClientAccount describe authontification
@@ -15,35 +17,211 @@ type ClientAccount struct {
 
 // Client used to for go-imgur
 type Client struct {
-	Log          klogger.KLogger
-	httpClient   *http.Client
-	imgurAccount ClientAccount
-	rapidAPIKey  string
+	Log             Logger
+	httpClient      *http.Client
+	imgurAccount    ClientAccount
+	rapidAPIKey     string
+	baseURL         string // overrides the API endpoint if set
+	userAgent       string
+	retryPolicy     RetryPolicy
+	throttleMode    ThrottleMode
+	tokenSource     TokenSource
+	rawJSON         bool // if the JSON of responses is kept, see WithRawJSON
+	middlewares     []func(http.RoundTripper) http.RoundTripper
+	callHooks       []CallHook
+	breaker         *circuitBreaker // nil if disabled
+	etagCache       ETagCache
+	cache           Cache
+	cacheTTL        time.Duration
+	maxResponseSize int64 // limit for the bodies of API responses, see WithMaxResponseSize
+	requestTimeout  time.Duration
+	uploadTimeout   time.Duration
+	proxy           func(*http.Request) (*url.URL, error) // nil if the proxy of the transport is kept, see WithProxy
+
+	skipUploadValidation bool // see WithUploadValidation
+
+	lowCreditsThreshold int64
+	lowCreditsFn        func(RateLimit)
+
+	mu         sync.Mutex
+	rateLimit  *RateLimit // last rate limit reported by imgur
+	lowCredits bool       // if the credits were below the threshold of lowCreditsFn
+	retryAfter time.Time  // imgur asked with a 429 response not to send requests before this time
+
+	imageFlights flightGroup // dedupes concurrent requests of GetImagesInfo
 }
 
-// NewClient simply creates an imgur client. RapidAPIKEY is "" if you are using the free API.
-func NewClient(httpClient *http.Client, clientID string, rapidAPIKey string) (*Client, error) {
-	logger := new(klogger.CLILogger)
-	return NewClientWithLogger(logger, httpClient, clientID, rapidAPIKey)
+// ClientOption configures optional behaviour of a Client
+type ClientOption func(*Client)
+
+// WithHTTPClient sets the http.Client used for all requests. Default is a new http.Client.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
 }
 
-func NewClientWithLogger(logger klogger.KLogger, httpClient *http.Client, clientID string, rapidAPIKey string) (*Client, error) {
-	if len(clientID) == 0 {
-		msg := "imgur client ID is empty"
-		logger.Errorf(msg)
-		return nil, fmt.Errorf(msg)
+// WithTransportMiddleware wraps the transport of the http.Client with middleware, e.g. to
+// trace, sign or record the requests. All requests of the client pass through it, including
+// retries and downloads. Middlewares are applied in the order they are given, the first
+// one sees a request first. The http.Client passed to WithHTTPClient is not changed.
+func WithTransportMiddleware(middleware func(http.RoundTripper) http.RoundTripper) ClientOption {
+	return func(c *Client) {
+		if middleware != nil {
+			c.middlewares = append(c.middlewares, middleware)
+		}
 	}
+}
 
-	if len(rapidAPIKey) == 0 {
-		logger.Infof("rapid api key is empty")
+// WithBaseURL sends all requests to baseURL instead of the imgur API,
+// e.g. a mock server or a proxy. baseURL replaces "https://api.imgur.com/3/", and the
+// RapidAPI endpoint if WithRapidAPIKey is used as well, whose headers are still sent then.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) {
+		if baseURL != "" && !strings.HasSuffix(baseURL, "/") {
+			baseURL += "/"
+		}
+		c.baseURL = baseURL
 	}
+}
 
-	return &Client{
-		httpClient:  httpClient,
-		Log:         logger,
-		rapidAPIKey: rapidAPIKey,
+// WithRapidAPIKey sends all requests through the commercial RapidAPI endpoint using key.
+// The requests go to the imgur API on the RapidAPI host with the key and host in the
+// x-rapidapi-key and x-rapidapi-host headers, and the request limits of the plan RapidAPI
+// reports are kept in the RateLimit and throttled like the imgur credits. An empty key
+// uses the free imgur API.
+func WithRapidAPIKey(key string) ClientOption {
+	return func(c *Client) {
+		c.rapidAPIKey = key
+	}
+}
+
+// WithLogger writes all messages of the client to logger. By default nothing is logged.
+func WithLogger(logger Logger) ClientOption {
+	return func(c *Client) {
+		if logger == nil {
+			logger = NopLogger{}
+		}
+		c.Log = logger
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request, including downloads
+// and token refreshes. Imgur asks applications to identify themselves, e.g. with
+// "my-app/1.0 " + DefaultUserAgent. An empty userAgent restores DefaultUserAgent.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		if userAgent == "" {
+			userAgent = DefaultUserAgent
+		}
+		c.userAgent = userAgent
+	}
+}
+
+// WithAccessToken authenticates all requests with the OAuth access token of a user
+// instead of the anonymous client ID.
+func WithAccessToken(accessToken string) ClientOption {
+	return func(c *Client) {
+		c.imgurAccount.accessToken = accessToken
+	}
+}
+
+// WithTokenSource authenticates all requests with the tokens of ts. Expired tokens
+// are refreshed through ts and a request rejected with 401 is sent once more.
+func WithTokenSource(ts TokenSource) ClientOption {
+	return func(c *Client) {
+		c.tokenSource = ts
+	}
+}
+
+// New creates an imgur client for clientID configured by opts.
+func New(clientID string, opts ...ClientOption) (*Client, error) {
+	client := &Client{
+		httpClient:      new(http.Client),
+		Log:             NopLogger{},
+		maxResponseSize: DefaultMaxResponseSize,
+		userAgent:       DefaultUserAgent,
+		retryPolicy:     DefaultRetryPolicy,
 		imgurAccount: ClientAccount{
 			clientID: clientID,
 		},
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(client)
+	}
+	if err := client.applyProxy(); err != nil {
+		client.Log.Errorf("%v", err)
+		return nil, err
+	}
+	client.applyMiddlewares()
+
+	if len(clientID) == 0 {
+		msg := "imgur client ID is empty"
+		client.Log.Errorf(msg)
+		return nil, fmt.Errorf(msg)
+	}
+
+	if len(client.rapidAPIKey) == 0 {
+		client.Log.Infof("rapid api key is empty")
+	}
+
+	return client, nil
+}
+
+// NewClient simply creates an imgur client. RapidAPIKEY is "" if you are using the free API.
+func NewClient(httpClient *http.Client, clientID string, rapidAPIKey string, opts ...ClientOption) (*Client, error) {
+	return NewClientWithLogger(NopLogger{}, httpClient, clientID, rapidAPIKey, opts...)
+}
+
+// NewClientWithLogger is like NewClient, but all messages are written to logger.
+func NewClientWithLogger(logger Logger, httpClient *http.Client, clientID string, rapidAPIKey string, opts ...ClientOption) (*Client, error) {
+	opts = append([]ClientOption{
+		WithLogger(logger),
+		WithHTTPClient(httpClient),
+		WithRapidAPIKey(rapidAPIKey),
+	}, opts...)
+	return New(clientID, opts...)
+}
+
+// applyMiddlewares replaces the http.Client with a copy whose transport is wrapped by the
+// middlewares. Responses are decompressed first, unless the transport disables compression,
+// and the ETag cache is the next layer, so middlewares see its responses.
+func (client *Client) applyMiddlewares() {
+	httpClient := &http.Client{}
+	if client.httpClient != nil {
+		*httpClient = *client.httpClient
+	}
+	transport := httpClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if t, ok := transport.(*http.Transport); !ok || !t.DisableCompression {
+		transport = &compressionTransport{next: transport}
+	}
+	if client.etagCache != nil {
+		transport = &etagTransport{next: transport, cache: client.etagCache, prefix: client.createAPIURL(""), maxSize: client.maxResponseSize}
+	}
+	for i := len(client.middlewares) - 1; i >= 0; i-- {
+		transport = client.middlewares[i](transport)
+	}
+	httpClient.Transport = transport
+	client.httpClient = httpClient
+}
+
+// accessToken returns the access token of the user, "" for anonymous clients
+func (client *Client) accessToken() string {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	return client.imgurAccount.accessToken
+}
+
+// authenticated reports whether requests are sent on behalf of a user
+func (client *Client) authenticated() bool {
+	return client.tokenSource != nil || client.accessToken() != ""
+}
+
+func (client *Client) setAccessToken(token string) {
+	client.mu.Lock()
+	client.imgurAccount.accessToken = token
+	client.mu.Unlock()
 }
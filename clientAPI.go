@@ -0,0 +1,150 @@
+package imgur
+
+import (
+	"context"
+	"image"
+	"io"
+	"io/fs"
+	"net/url"
+	"time"
+)
+
+// ClientAPI is implemented by *Client. Code that depends on ClientAPI instead of *Client
+// can replace the client with a mock in its unit tests.
+// Methods added to Client are added to ClientAPI as well, so implementations outside
+// of this package should embed ClientAPI to keep compiling.
+type ClientAPI interface {
+	// Images
+	GetImageInfo(id ImageID) (*ImageInfo, int, error)
+	GetImageInfoWithContext(ctx context.Context, id ImageID) (*ImageInfo, int, error)
+	DeleteImage(ctx context.Context, image ImageRef) (*DeleteResult, int, error)
+	DeleteImages(ctx context.Context, idsOrDeleteHashes ...string) []BulkDeleteResult
+	UpdateImage(ctx context.Context, image ImageRef, title string, description string) (int, error)
+	GetImagesInfo(ctx context.Context, ids ...ImageID) []ImageInfoResult
+	WaitForProcessing(ctx context.Context, imageID ImageID) (*ImageInfo, int, error)
+	DownloadImage(ctx context.Context, idOrURL string) (io.ReadCloser, *ImageInfo, error)
+
+	// Uploads
+	Upload(ctx context.Context, source UploadSource, opts ...UploadOption) (*ImageInfo, int, error)
+	NewUpload(source UploadSource) *UploadBuilder
+	UploadImage(image []byte, album string, dtype UploadType, title string, description string) (*ImageInfo, int, error)
+	UploadImageWithContext(ctx context.Context, image []byte, album string, dtype UploadType, title string, description string) (*ImageInfo, int, error)
+	UploadImageFromReader(ctx context.Context, r io.Reader, size int64, opts ...UploadOption) (*ImageInfo, int, error)
+	UploadImageBase64(ctx context.Context, raw []byte, opts ...UploadOption) (*ImageInfo, int, error)
+	UploadFromFS(ctx context.Context, fsys fs.FS, name string, opts ...UploadOption) (*ImageInfo, int, error)
+	UploadGoImage(ctx context.Context, img image.Image, format EncodeFormat, opts ...UploadOption) (*ImageInfo, int, error)
+	UploadImageFromFile(filename string, album string, title string, description string) (*ImageInfo, int, error)
+	UploadImageFromFileWithContext(ctx context.Context, filename string, album string, title string, description string) (*ImageInfo, int, error)
+	UploadVideo(ctx context.Context, r io.Reader, size int64, opts ...UploadOption) (*ImageInfo, int, error)
+	UploadVideoFromFile(ctx context.Context, filename string, opts ...UploadOption) (*ImageInfo, int, error)
+	UploadImageFromURL(ctx context.Context, imageURL string, opts ...UploadOption) (*ImageInfo, int, error)
+	MirrorImage(ctx context.Context, sourceURL string, opts ...UploadOption) (*ImageInfo, int, error)
+	UploadMany(ctx context.Context, sources []UploadSource, opts BulkOptions) []UploadResult
+	UploadDirectoryAsAlbum(ctx context.Context, dir string, opts AlbumOptions) (*CreatedAlbum, []UploadResult, error)
+	UploadFSAsAlbum(ctx context.Context, fsys fs.FS, opts AlbumOptions) (*CreatedAlbum, []UploadResult, error)
+
+	// Albums
+	GetAlbumInfo(id AlbumID) (*AlbumInfo, int, error)
+	GetAlbumInfoWithContext(ctx context.Context, id AlbumID) (*AlbumInfo, int, error)
+	CreateAlbum(ctx context.Context, opts AlbumOptions) (*CreatedAlbum, int, error)
+	CreateAnonymousAlbum(ctx context.Context, opts AlbumOptions) (*CreatedAlbum, int, error)
+	UpdateAlbum(ctx context.Context, album AlbumRef, opts AlbumOptions) (int, error)
+	SetAlbumCover(ctx context.Context, album AlbumRef, imageID ImageID) (int, error)
+	DeleteAlbum(ctx context.Context, album AlbumRef) (int, error)
+	AddImagesToAlbum(ctx context.Context, album AlbumRef, images ...ImageRef) (int, error)
+	RemoveImagesFromAlbum(ctx context.Context, album AlbumRef, images ...ImageRef) (int, error)
+	AddImagesToAnonymousAlbum(ctx context.Context, album *CreatedAlbum, images ...DeleteHash) (int, error)
+	DeleteAnonymousAlbum(ctx context.Context, album *CreatedAlbum) (int, error)
+	DownloadAlbum(ctx context.Context, albumID AlbumID, destDir string, opts DownloadOptions) (*AlbumInfo, error)
+	DownloadAlbumZip(ctx context.Context, albumID AlbumID, w io.Writer, progress ProgressFunc) (int64, error)
+	ExportAccount(ctx context.Context, w io.Writer, opts ExportOptions) error
+	SyncDirectory(ctx context.Context, dir string, opts SyncOptions) (*SyncResult, error)
+	NewWatcher(opts ...WatcherOption) *Watcher
+	WatchAlbum(ctx context.Context, albumID AlbumID, interval time.Duration) <-chan AlbumEvent
+
+	// Gallery
+	GetGallery(ctx context.Context, section GallerySection, sort GallerySort, window GalleryWindow, page int, showViral bool, mature bool) ([]GalleryItem, int, error)
+	Gallery(section GallerySection, sort GallerySort, window GalleryWindow, showViral bool, mature bool) *GalleryIterator
+	GetGalleryImageInfo(id ImageID) (*GalleryImageInfo, int, error)
+	GetGalleryImageInfoWithContext(ctx context.Context, id ImageID) (*GalleryImageInfo, int, error)
+	GetGalleryAlbumInfo(id AlbumID) (*GalleryAlbumInfo, int, error)
+	GetGalleryAlbumInfoWithContext(ctx context.Context, id AlbumID) (*GalleryAlbumInfo, int, error)
+	GetGalleryComments(ctx context.Context, post PostRef, sort CommentSort) ([]Comment, int, error)
+	GetGalleryCommentCount(ctx context.Context, post PostRef) (int, int, error)
+	GallerySearch(ctx context.Context, query string, opts SearchOptions) ([]GalleryItem, int, error)
+	GallerySearchAdvanced(ctx context.Context, query *SearchQuery, opts SearchOptions) ([]GalleryItem, int, error)
+	ShareToGallery(ctx context.Context, post PostRef, title string, topic string, mature bool, tags []string) (int, error)
+	RemoveFromGallery(ctx context.Context, post PostRef) (int, error)
+	GetSubredditGallery(ctx context.Context, subreddit string, sort GallerySort, window GalleryWindow, page int) ([]GalleryItem, int, error)
+	GetSubredditImage(ctx context.Context, subreddit string, imageID ImageID) (*GalleryImageInfo, int, error)
+	GetTag(ctx context.Context, tagname string) (*Tag, int, error)
+	GetTagGallery(ctx context.Context, tagname string, sort GallerySort, page int) (*Tag, int, error)
+	UpdateGalleryTags(ctx context.Context, post PostRef, tags []string) (int, error)
+	VoteGalleryTag(ctx context.Context, post PostRef, tagname string, vote Vote) (int, error)
+	VoteGallery(ctx context.Context, post PostRef, vote Vote) (int, error)
+	GetGalleryVotes(ctx context.Context, post PostRef) (*GalleryVotes, int, error)
+
+	// Comments
+	GetComment(ctx context.Context, commentID CommentID) (*Comment, int, error)
+	GetCommentReplies(ctx context.Context, commentID CommentID) (*Comment, int, error)
+	CreateComment(ctx context.Context, post PostRef, comment string) (CommentID, int, error)
+	ReplyToComment(ctx context.Context, parentID CommentID, post PostRef, comment string) (CommentID, int, error)
+	DeleteComment(ctx context.Context, commentID CommentID) (int, error)
+	VoteComment(ctx context.Context, commentID CommentID, vote Vote) (int, error)
+	ReportComment(ctx context.Context, commentID CommentID, reason ReportReason) (int, error)
+
+	// Accounts
+	RefreshAccessToken(refreshToken string, clientSecret string) (string, error)
+	RefreshAccessTokenWithContext(ctx context.Context, refreshToken string, clientSecret string) (string, error)
+	GetAccount(ctx context.Context, username string) (*Account, int, error)
+	GetAccountGalleryProfile(ctx context.Context, username string) (*GalleryProfile, int, error)
+	GetAccountSettings(ctx context.Context) (*AccountSettings, int, error)
+	UpdateAccountSettings(ctx context.Context, patch SettingsPatch) (int, error)
+	HasVerifiedEmail(ctx context.Context) (bool, int, error)
+	SendVerificationEmail(ctx context.Context) (int, error)
+	GetAccountAlbums(ctx context.Context, username string, page int) ([]AlbumInfo, int, error)
+	GetAccountAlbumIDs(ctx context.Context, username string, page int) ([]AlbumID, int, error)
+	GetAccountAlbumCount(ctx context.Context, username string) (int, int, error)
+	AccountAlbums(username string) *AlbumIterator
+	GetAccountImages(ctx context.Context, username string, page int) ([]ImageInfo, int, error)
+	GetAccountImageIDs(ctx context.Context, username string, page int) ([]ImageID, int, error)
+	GetAccountImageCount(ctx context.Context, username string) (int, int, error)
+	AccountImages(username string) *ImageIterator
+	GetAccountComments(ctx context.Context, username string, sort CommentSort, page int) ([]Comment, int, error)
+	GetAccountCommentIDs(ctx context.Context, username string, sort CommentSort, page int) ([]CommentID, int, error)
+	GetAccountCommentCount(ctx context.Context, username string) (int, int, error)
+	AccountComments(username string, sort CommentSort) *CommentIterator
+	GetAccountFavorites(ctx context.Context, username string, page int, sort FavoriteSort) ([]GalleryItem, int, error)
+	GetAccountGalleryFavorites(ctx context.Context, username string, page int, sort FavoriteSort) ([]GalleryItem, int, error)
+	GetAccountSubmissions(ctx context.Context, username string, page int) ([]GalleryItem, int, error)
+	GetAccountAvailableAvatars(ctx context.Context) ([]Avatar, int, error)
+	GetAccountAvatar(ctx context.Context, username string) (*Avatar, int, error)
+	GetBlockStatus(ctx context.Context, username string) (bool, int, error)
+	BlockUser(ctx context.Context, username string) (int, error)
+	UnblockUser(ctx context.Context, username string) (int, error)
+	ListBlockedUsers(ctx context.Context) ([]BlockedUser, int, error)
+	FavoriteImage(ctx context.Context, imageID ImageID) (bool, int, error)
+	FavoriteAlbum(ctx context.Context, albumID AlbumID) (bool, int, error)
+
+	// Messages and notifications
+	ListConversations(ctx context.Context) ([]Conversation, int, error)
+	GetConversation(ctx context.Context, conversationID int, page int) (*Conversation, int, error)
+	SendMessage(ctx context.Context, recipient string, body string) (int, error)
+	DeleteConversation(ctx context.Context, conversationID int) (int, error)
+	ReportSender(ctx context.Context, username string) (int, error)
+	BlockSender(ctx context.Context, username string) (int, error)
+	GetNotifications(ctx context.Context, onlyNew bool) (*Notifications, int, error)
+	MarkNotificationsViewed(ctx context.Context, ids ...int) (int, error)
+
+	// Rate limits and raw requests
+	GetRateLimit() (*RateLimit, error)
+	GetRateLimitWithContext(ctx context.Context) (*RateLimit, error)
+	GetCredits(ctx context.Context) (*RateLimit, int, error)
+	VerifyCredentials(ctx context.Context) (*Verification, int, error)
+	LastRateLimit() *RateLimit
+	Do(ctx context.Context, method string, path string, params url.Values, v interface{}) (*RateLimit, int, error)
+	GetInfoFromURL(url string) (*GenericInfo, int, error)
+	GetInfoFromURLWithContext(ctx context.Context, url string) (*GenericInfo, int, error)
+}
+
+var _ ClientAPI = (*Client)(nil)
@@ -0,0 +1,43 @@
+package imgur
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientAPICoversClient(t *testing.T) {
+	api := reflect.TypeOf((*ClientAPI)(nil)).Elem()
+	client := reflect.TypeOf(&Client{})
+	for i := 0; i < client.NumMethod(); i++ {
+		name := client.Method(i).Name
+		_, ok := api.MethodByName(name)
+		require.Truef(t, ok, "ClientAPI is missing %v", name)
+	}
+}
+
+type fakeImageAPI struct {
+	ClientAPI
+	images map[ImageID]*ImageInfo
+}
+
+func (f *fakeImageAPI) GetImageInfoWithContext(ctx context.Context, id ImageID) (*ImageInfo, int, error) {
+	if img, ok := f.images[id]; ok {
+		return img, 200, nil
+	}
+	return nil, 404, ErrNotFound
+}
+
+func TestClientAPIMock(t *testing.T) {
+	var api ClientAPI = &fakeImageAPI{images: map[ImageID]*ImageInfo{"ClF8rLe": {ID: "ClF8rLe", Title: "mocked"}}}
+
+	img, status, err := api.GetImageInfoWithContext(context.Background(), "ClF8rLe")
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Equal(t, "mocked", img.Title)
+
+	_, _, err = api.GetImageInfoWithContext(context.Background(), "missing")
+	require.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,99 @@
+package imgur
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// ClientPool distributes requests across the clients of several registered applications
+// or users, e.g. for services whose traffic exceeds the credits of a single application.
+// The credits of every client are tracked by the client itself, from the rate limits
+// imgur reports with its responses.
+//
+//	pool, err := imgur.NewClientPool(first, second)
+//	img, _, err := pool.Client().GetImageInfo(id)
+type ClientPool struct {
+	clients []*Client
+
+	mu   sync.Mutex
+	next int // the client preferred among clients with as many remaining credits
+}
+
+// NewClientPool creates a pool of clients, each configured with its own credentials
+func NewClientPool(clients ...*Client) (*ClientPool, error) {
+	if len(clients) == 0 {
+		return nil, fmt.Errorf("imgur client pool has no clients")
+	}
+	for i, client := range clients {
+		if client == nil {
+			return nil, fmt.Errorf("Client %v of the imgur client pool is nil", i)
+		}
+	}
+	return &ClientPool{clients: append([]*Client(nil), clients...)}, nil
+}
+
+// Client returns the client with the most remaining credits to send the next request with.
+// Clients whose credits are not known yet count as having all credits left, clients
+// imgur asked to hold back requests with a 429 response are used last. Among clients
+// with as many credits the pool rotates, so the requests are spread evenly.
+func (p *ClientPool) Client() *Client {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	best, bestCredits := -1, int64(0)
+	for n := range p.clients {
+		i := (p.next + n) % len(p.clients)
+		if credits := p.clients[i].remainingCredits(); best < 0 || credits > bestCredits {
+			best, bestCredits = i, credits
+		}
+	}
+	p.next = (best + 1) % len(p.clients)
+	return p.clients[best]
+}
+
+// Clients returns the clients of the pool
+func (p *ClientPool) Clients() []*Client {
+	return append([]*Client(nil), p.clients...)
+}
+
+// RateLimits returns the last rate limits reported for every client of the pool, in the
+// order of Clients. The rate limit of a client that did not get a response yet is nil.
+func (p *ClientPool) RateLimits() []*RateLimit {
+	limits := make([]*RateLimit, len(p.clients))
+	for i, client := range p.clients {
+		limits[i] = client.LastRateLimit()
+	}
+	return limits
+}
+
+// remainingCredits returns the number of requests the client can send before one of its
+// rate limits is exhausted, math.MaxInt64 if none is known and -1 while imgur asked to
+// hold back requests
+func (client *Client) remainingCredits() int64 {
+	client.mu.Lock()
+	retryAfter := client.retryAfter
+	client.mu.Unlock()
+	now := time.Now()
+	if now.Before(retryAfter) {
+		return -1
+	}
+	rl := client.LastRateLimit()
+	if rl == nil {
+		return math.MaxInt64
+	}
+
+	remaining := int64(math.MaxInt64)
+	if rl.ClientLimit > 0 && rl.ClientRemaining < remaining {
+		remaining = rl.ClientRemaining
+	}
+	// the user and RapidAPI limits start over once they were reset
+	if rl.UserLimit > 0 && rl.UserRemaining < remaining && now.Before(rl.UserReset) {
+		remaining = rl.UserRemaining
+	}
+	if rl.RapidAPILimit > 0 && rl.RapidAPIRemaining < remaining && now.Before(rl.RapidAPIReset) {
+		remaining = rl.RapidAPIRemaining
+	}
+	return remaining
+}
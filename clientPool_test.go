@@ -0,0 +1,77 @@
+package imgur
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientPool(t *testing.T) {
+	remaining := map[string]int{"Client-ID first": 100, "Client-ID second": 300, "Client-ID third": 200}
+	requests := map[string]int{}
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		requests[auth]++
+		remaining[auth] -= 100
+		w.Header().Set("X-RateLimit-ClientLimit", "12500")
+		w.Header().Set("X-RateLimit-ClientRemaining", strconv.Itoa(remaining[auth]))
+		fmt.Fprint(w, `{"data":{"id":"ClF8rLe"},"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	first, _ := NewClient(httpC, "first", "")
+	second, _ := NewClient(httpC, "second", "")
+	third, _ := NewClient(httpC, "third", "")
+	pool, err := NewClientPool(first, second, third)
+	require.NoError(t, err)
+
+	// the credits are unknown, so the clients are used in turn
+	for _, want := range []*Client{first, second, third} {
+		client := pool.Client()
+		require.Equal(t, want, client)
+		_, _, err := client.GetImageInfo("ClF8rLe")
+		require.NoError(t, err)
+	}
+
+	// second has 200 credits left, third 100 and first none
+	require.Equal(t, second, pool.Client())
+	_, _, err = pool.Client().GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+	require.Equal(t, 2, requests["Client-ID second"])
+
+	limits := pool.RateLimits()
+	require.Len(t, limits, 3)
+	require.Equal(t, int64(0), limits[0].ClientRemaining)
+	require.Equal(t, int64(100), limits[1].ClientRemaining)
+	require.Equal(t, int64(100), limits[2].ClientRemaining)
+
+	// second and third are tied and used in turn
+	require.Equal(t, third, pool.Client())
+	require.Equal(t, second, pool.Client())
+	require.Equal(t, []*Client{first, second, third}, pool.Clients())
+}
+
+func TestClientPoolRetryAfter(t *testing.T) {
+	first, _ := New("first")
+	second, _ := New("second")
+	pool, _ := NewClientPool(first, second)
+
+	first.mu.Lock()
+	first.retryAfter = time.Now().Add(time.Minute)
+	first.mu.Unlock()
+	second.rateLimit = &RateLimit{ClientLimit: 12500, ClientRemaining: 0}
+	require.Equal(t, second, pool.Client())
+	require.Equal(t, second, pool.Client())
+}
+
+func TestNewClientPoolInvalid(t *testing.T) {
+	_, err := NewClientPool()
+	require.Error(t, err)
+	client, _ := New("testing")
+	_, err = NewClientPool(client, nil)
+	require.Error(t, err)
+}
@@ -1,9 +1,12 @@
 package imgur
 
 import (
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/koffeinsource/go-klogger"
 	"github.com/stretchr/testify/require"
 )
 
@@ -16,3 +19,90 @@ func TestClientCreationWithoutClientID(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, client)
 }
+
+func TestNewWithOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/mock/3/image/ClF8rLe", r.URL.Path)
+		require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		require.Equal(t, "my-app/1.0", r.Header.Get("User-Agent"))
+		require.Equal(t, "rapid", r.Header.Get("X-RapidAPI-Key"))
+		fmt.Fprint(w, `{"data":{"id":"ClF8rLe"},"success":true,"status":200}`)
+	}))
+	defer server.Close()
+
+	client, err := New("testing",
+		WithHTTPClient(server.Client()),
+		WithBaseURL(server.URL+"/mock/3"),
+		WithRapidAPIKey("rapid"),
+		WithUserAgent("my-app/1.0"),
+		WithAccessToken("token"),
+		WithLogger(new(klogger.CLILogger)),
+	)
+	require.NoError(t, err)
+
+	img, _, err := client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+	require.Equal(t, ImageID("ClF8rLe"), img.ID)
+}
+
+func TestNewAnonymous(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Client-ID testing", r.Header.Get("Authorization"))
+		require.Equal(t, "", r.Header.Get("X-RapidAPI-Key"))
+		require.Equal(t, DefaultUserAgent, r.Header.Get("User-Agent"))
+		fmt.Fprint(w, `{"data":{"id":"ClF8rLe"},"success":true,"status":200}`)
+	}))
+	defer server.Close()
+
+	client, err := New("testing", WithHTTPClient(server.Client()), WithBaseURL(server.URL+"/3/"))
+	require.NoError(t, err)
+
+	_, _, err = client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+
+	_, err = New("")
+	require.Error(t, err)
+
+	client, _ = New("testing", WithUserAgent("my-app/1.0"), WithUserAgent(""))
+	require.Equal(t, DefaultUserAgent, client.userAgent)
+}
+
+func TestOAuthURLFollowsBaseURL(t *testing.T) {
+	client, err := New("testing")
+	require.NoError(t, err)
+	require.Equal(t, "https://api.imgur.com/oauth2/token", client.createRootURL("oauth2/token"))
+
+	client, err = New("testing", WithBaseURL("http://localhost:1234/3/"))
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:1234/oauth2/token", client.createRootURL("oauth2/token"))
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestWithTransportMiddleware(t *testing.T) {
+	httpC, server := testHTTPClientJSON(`{"data":{"id":"ClF8rLe"},"success":true,"status":200}`)
+	defer server.Close()
+
+	var calls []string
+	middleware := func(name string) func(http.RoundTripper) http.RoundTripper {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				calls = append(calls, name+" "+r.URL.Path)
+				return next.RoundTrip(r)
+			})
+		}
+	}
+	transport := httpC.Transport
+
+	client, err := New("id", WithTransportMiddleware(middleware("outer")), WithHTTPClient(httpC), WithTransportMiddleware(middleware("inner")), WithTransportMiddleware(nil))
+	require.NoError(t, err)
+	img, _, err := client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+	require.Equal(t, ImageID("ClF8rLe"), img.ID)
+	require.Equal(t, []string{"outer /3/image/ClF8rLe", "inner /3/image/ClF8rLe"}, calls)
+	require.Equal(t, transport, httpC.Transport)
+}
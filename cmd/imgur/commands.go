@@ -0,0 +1,250 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/koffeinsource/go-imgur"
+)
+
+// parseFlags parses the flags of a command and returns the remaining arguments
+func (a *app) parseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	fs.SetOutput(a.stdout)
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	return fs.Args(), nil
+}
+
+// upload uploads files and prints their links, and the deletehashes of anonymous uploads
+func (a *app) upload(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("upload", flag.ContinueOnError)
+	title := fs.String("title", "", "Title of the uploaded images")
+	description := fs.String("description", "", "Description of the uploaded images")
+	album := fs.String("album", "", "Album to add the images to, the deletehash for anonymous albums")
+	files, err := a.parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("No files given to upload")
+	}
+	s, err := a.newClient()
+	if err != nil {
+		return err
+	}
+
+	var opts []imgur.UploadOption
+	if *title != "" {
+		opts = append(opts, imgur.WithTitle(*title))
+	}
+	if *description != "" {
+		opts = append(opts, imgur.WithDescription(*description))
+	}
+	if *album != "" {
+		opts = append(opts, imgur.WithAlbum(s.albumRef(*album)))
+	}
+	for _, file := range files {
+		// UploadFromFS sends videos to the video upload by their extension
+		img, _, err := s.UploadFromFS(ctx, os.DirFS(filepath.Dir(file)), filepath.Base(file), opts...)
+		if err != nil {
+			return err
+		}
+		if s.user {
+			fmt.Fprintln(a.stdout, img.Link)
+		} else {
+			fmt.Fprintf(a.stdout, "%v\tdeletehash %v\n", img.Link, img.Deletehash)
+		}
+	}
+	return nil
+}
+
+// download saves images and albums, given by ID or link, in a directory
+func (a *app) download(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("download", flag.ContinueOnError)
+	dir := fs.String("o", ".", "Directory to save the images in, albums get a directory of their own")
+	refs, err := a.parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(refs) == 0 {
+		return fmt.Errorf("No images or albums given to download")
+	}
+	s, err := a.newClient()
+	if err != nil {
+		return err
+	}
+
+	for _, ref := range refs {
+		if link, err := imgur.ParseURL(ref); err == nil && link.Kind == imgur.URLAlbum {
+			albumDir := filepath.Join(*dir, link.ID)
+			if _, err := s.DownloadAlbum(ctx, imgur.AlbumID(link.ID), albumDir, imgur.DownloadOptions{}); err != nil {
+				return err
+			}
+			fmt.Fprintln(a.stdout, albumDir)
+			continue
+		}
+
+		body, img, err := s.DownloadImage(ctx, ref)
+		if err != nil {
+			return err
+		}
+		name := filepath.Join(*dir, string(img.ID)+linkExt(img.Link))
+		err = writeFile(name, body)
+		body.Close()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(a.stdout, name)
+	}
+	return nil
+}
+
+// linkExt returns the file extension of a direct link
+func linkExt(link string) string {
+	u, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+	return path.Ext(u.Path)
+}
+
+func writeFile(name string, r io.Reader) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return fmt.Errorf("Problem creating %v - %w", name, err)
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return fmt.Errorf("Problem writing %v - %w", name, err)
+	}
+	return f.Close()
+}
+
+// albumCreate creates an album of images, which are given by their deletehashes
+// for anonymous albums
+func (a *app) albumCreate(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("album create", flag.ContinueOnError)
+	title := fs.String("title", "", "Title of the album")
+	description := fs.String("description", "", "Description of the album")
+	privacy := fs.String("privacy", "", "Who can see the album: public, hidden or secret")
+	images, err := a.parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	s, err := a.newClient()
+	if err != nil {
+		return err
+	}
+
+	opts := imgur.AlbumOptions{Title: *title, Description: *description, Privacy: imgur.AlbumPrivacy(*privacy)}
+	if s.user {
+		opts.ImageIDs = imgur.ImageIDs(images...)
+	} else {
+		opts.DeleteHashes = imgur.DeleteHashes(images...)
+	}
+	album, _, err := s.CreateAlbum(ctx, opts)
+	if err != nil {
+		return err
+	}
+	if s.user {
+		fmt.Fprintln(a.stdout, imgur.AlbumPageURL(album.ID))
+	} else {
+		fmt.Fprintf(a.stdout, "%v\tdeletehash %v\n", imgur.AlbumPageURL(album.ID), album.Deletehash)
+	}
+	return nil
+}
+
+// albumAdd adds images to an album, anonymous albums and their images are given by
+// their deletehashes
+func (a *app) albumAdd(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("album add", flag.ContinueOnError)
+	args, err := a.parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(args) < 2 {
+		return fmt.Errorf("An album and the images to add are needed")
+	}
+	s, err := a.newClient()
+	if err != nil {
+		return err
+	}
+
+	images := make([]imgur.ImageRef, len(args)-1)
+	for i, image := range args[1:] {
+		if s.user {
+			images[i] = imgur.ImageID(image)
+		} else {
+			images[i] = imgur.DeleteHash(image)
+		}
+	}
+	_, err = s.AddImagesToAlbum(ctx, s.albumRef(args[0]), images...)
+	return err
+}
+
+// albumRef returns the reference of an album given on the command line
+func (s *session) albumRef(album string) imgur.AlbumRef {
+	if s.user {
+		return imgur.AlbumID(album)
+	}
+	return imgur.DeleteHash(album)
+}
+
+// albumList prints the albums of a user
+func (a *app) albumList(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("album list", flag.ContinueOnError)
+	user := fs.String("user", imgur.Me, "The user whose albums are listed")
+	if _, err := a.parseFlags(fs, args); err != nil {
+		return err
+	}
+	s, err := a.newClient()
+	if err != nil {
+		return err
+	}
+
+	it := s.AccountAlbums(*user)
+	defer it.Close()
+	for it.Next(ctx) {
+		album := it.Album()
+		fmt.Fprintf(a.stdout, "%v\t%v images\t%v\n", album.ID, album.ImagesCount, album.Title)
+	}
+	return it.Err()
+}
+
+// gallerySearch prints the links and titles of the gallery posts matching a query
+func (a *app) gallerySearch(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("gallery search", flag.ContinueOnError)
+	sort := fs.String("sort", "", "Order of the results: time, viral or top")
+	window := fs.String("window", "", "Time range with -sort top: day, week, month, year or all")
+	page := fs.Int("page", 0, "Page of the results, starting at 0")
+	words, err := a.parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	s, err := a.newClient()
+	if err != nil {
+		return err
+	}
+
+	opts := imgur.SearchOptions{Sort: imgur.GallerySort(*sort), Window: imgur.GalleryWindow(*window), Page: *page}
+	items, _, err := s.GallerySearch(ctx, strings.Join(words, " "), opts)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if item.IsAlbum() {
+			fmt.Fprintf(a.stdout, "%v\t%v\n", imgur.GalleryPageURL(item.AsAlbum().ID), item.AsAlbum().Title)
+		} else {
+			fmt.Fprintf(a.stdout, "%v\t%v\n", imgur.GalleryPageURL(item.AsImage().ID), item.AsImage().Title)
+		}
+	}
+	return nil
+}
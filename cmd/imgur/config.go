@@ -0,0 +1,135 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/koffeinsource/go-imgur"
+	"github.com/koffeinsource/go-imgur/oauth"
+)
+
+// userAgent identifies the command and the library to imgur
+const userAgent = "imgur-cli " + imgur.DefaultUserAgent
+
+// config are the credentials of the application
+type config struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	BaseURL      string `json:"base_url,omitempty"`       // URL of the API, the imgur API if empty
+	OAuthURL     string `json:"oauth_endpoint,omitempty"` // URL of the OAuth2 API, oauth.DefaultEndpoint if empty
+
+	dir string // The config directory
+}
+
+// loadConfig reads the config file and applies the environment to it
+func (a *app) loadConfig() (*config, error) {
+	dir := a.getenv("IMGUR_CONFIG_DIR")
+	if dir == "" {
+		userDir, err := os.UserConfigDir()
+		if err != nil {
+			return nil, fmt.Errorf("Could not find config directory, set IMGUR_CONFIG_DIR - %w", err)
+		}
+		dir = filepath.Join(userDir, "imgur")
+	}
+
+	cfg := &config{dir: dir}
+	name := filepath.Join(dir, "config.json")
+	data, err := os.ReadFile(name)
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(data, cfg); err != nil {
+			return nil, fmt.Errorf("Problem decoding %v - %w", name, err)
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return nil, fmt.Errorf("Could not read %v - %w", name, err)
+	}
+
+	if id := a.getenv("IMGUR_CLIENT_ID"); id != "" {
+		cfg.ClientID = id
+	}
+	if secret := a.getenv("IMGUR_CLIENT_SECRET"); secret != "" {
+		cfg.ClientSecret = secret
+	}
+	if cfg.ClientID == "" {
+		return nil, fmt.Errorf("No client ID configured, set IMGUR_CLIENT_ID or client_id in %v", name)
+	}
+	return cfg, nil
+}
+
+// oauth returns the OAuth configuration of the application
+func (cfg *config) oauth() *oauth.Config {
+	return &oauth.Config{ClientID: cfg.ClientID, ClientSecret: cfg.ClientSecret, Endpoint: cfg.OAuthURL, UserAgent: userAgent}
+}
+
+// tokenStore returns the store of the token of the user. The token is encrypted with
+// a key next to it, so it does not end up in backups or logs as plain text by accident.
+func (cfg *config) tokenStore() (*oauth.FileStore, error) {
+	if err := os.MkdirAll(cfg.dir, 0700); err != nil {
+		return nil, fmt.Errorf("Could not create config directory %v - %w", cfg.dir, err)
+	}
+	name := filepath.Join(cfg.dir, "token.key")
+	key, err := os.ReadFile(name)
+	if errors.Is(err, os.ErrNotExist) {
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+		err = os.WriteFile(name, key, 0600)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Could not read or create key %v - %w", name, err)
+	}
+	return oauth.NewFileStore(filepath.Join(cfg.dir, "token"), key)
+}
+
+// session is a client of the command
+type session struct {
+	*imgur.Client
+	user bool // If the client acts on behalf of a user instead of anonymously
+}
+
+// newClient creates a client authenticated with the access token of the environment or
+// the stored token of the user, and an anonymous client if there is neither
+func (a *app) newClient() (*session, error) {
+	cfg, err := a.loadConfig()
+	if err != nil {
+		return nil, err
+	}
+	opts := []imgur.ClientOption{imgur.WithUserAgent(userAgent)}
+	if cfg.BaseURL != "" {
+		opts = append(opts, imgur.WithBaseURL(cfg.BaseURL))
+	}
+
+	s := &session{}
+	if token := a.getenv("IMGUR_ACCESS_TOKEN"); token != "" {
+		opts = append(opts, imgur.WithAccessToken(token))
+		s.user = true
+	} else {
+		store, err := cfg.tokenStore()
+		if err != nil {
+			return nil, err
+		}
+		token, err := store.Load()
+		switch {
+		case errors.Is(err, oauth.ErrNoToken):
+		case err != nil:
+			return nil, err
+		case cfg.ClientSecret != "" && token.RefreshToken != "":
+			save := func(t *imgur.Token) { _ = store.Save(t) }
+			opts = append(opts, imgur.WithTokenSource(cfg.oauth().TokenSource(token, save)))
+			s.user = true
+		default:
+			opts = append(opts, imgur.WithAccessToken(token.AccessToken))
+			s.user = true
+		}
+	}
+
+	if s.Client, err = imgur.New(cfg.ClientID, opts...); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
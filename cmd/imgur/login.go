@@ -0,0 +1,46 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+)
+
+// login authorizes the application to act on behalf of the user with the PIN flow of
+// imgur and stores the token. The user grants access in the browser and enters the
+// PIN imgur shows, so no callback has to be reachable from the browser.
+func (a *app) login(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("login", flag.ContinueOnError)
+	if _, err := a.parseFlags(fs, args); err != nil {
+		return err
+	}
+	cfg, err := a.loadConfig()
+	if err != nil {
+		return err
+	}
+	if cfg.ClientSecret == "" {
+		return fmt.Errorf("Logging in needs the client secret, set IMGUR_CLIENT_SECRET or client_secret in the config")
+	}
+	store, err := cfg.tokenStore()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(a.stdout, "Open this link and grant access:\n%v\n", cfg.oauth().AuthorizePinURL(""))
+	fmt.Fprint(a.stdout, "Enter the PIN imgur shows: ")
+	pin, err := bufio.NewReader(a.stdin).ReadString('\n')
+	if err != nil && (err != io.EOF || pin == "") {
+		return fmt.Errorf("Could not read the PIN - %w", err)
+	}
+	token, err := cfg.oauth().ExchangePin(ctx, pin)
+	if err != nil {
+		return err
+	}
+	if err := store.Save(token); err != nil {
+		return err
+	}
+	fmt.Fprintf(a.stdout, "Logged in as %v\n", token.AccountUsername)
+	return nil
+}
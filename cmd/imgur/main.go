@@ -0,0 +1,93 @@
+// Command imgur uploads and downloads images, manages albums and searches the gallery
+// from the terminal.
+//
+//	imgur upload [-title t] [-description d] [-album id] file...
+//	imgur download [-o dir] id-or-url...
+//	imgur album create [-title t] [-description d] [-privacy p] image...
+//	imgur album add album image...
+//	imgur album list [-user name]
+//	imgur gallery search [-sort s] [-window w] [-page n] query...
+//	imgur watch [-album id] [-clipboard] dir
+//	imgur login
+//
+// The client ID and secret of the application are read from the config.json file in the
+// config directory, which is $IMGUR_CONFIG_DIR or the imgur directory in the user config
+// directory, and can be overridden by IMGUR_CLIENT_ID and IMGUR_CLIENT_SECRET.
+// login stores the token of the user in the config directory, IMGUR_ACCESS_TOKEN
+// authenticates without it. Without a token, the command acts anonymously and albums
+// are changed using the deletehashes of the albums and images.
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+)
+
+const usage = `Usage:
+  imgur upload [-title t] [-description d] [-album id] file...
+  imgur download [-o dir] id-or-url...
+  imgur album create [-title t] [-description d] [-privacy p] image...
+  imgur album add album image...
+  imgur album list [-user name]
+  imgur gallery search [-sort s] [-window w] [-page n] query...
+  imgur watch [-album id] [-clipboard] dir
+  imgur login
+`
+
+// app runs the commands, its fields are replaced in tests
+type app struct {
+	getenv func(string) string
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	a := &app{getenv: os.Getenv, stdin: os.Stdin, stdout: os.Stdout, stderr: os.Stderr}
+	if err := a.run(ctx, os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "imgur: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run executes the command given by args
+func (a *app) run(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("No command given\n%v", usage)
+	}
+	command, args := args[0], args[1:]
+	sub := ""
+	if len(args) > 0 {
+		sub = args[0]
+	}
+	switch {
+	case command == "upload":
+		return a.upload(ctx, args)
+	case command == "download":
+		return a.download(ctx, args)
+	case command == "watch":
+		return a.watch(ctx, args)
+	case command == "album" && sub == "create":
+		return a.albumCreate(ctx, args[1:])
+	case command == "album" && sub == "add":
+		return a.albumAdd(ctx, args[1:])
+	case command == "album" && sub == "list":
+		return a.albumList(ctx, args[1:])
+	case command == "gallery" && sub == "search":
+		return a.gallerySearch(ctx, args[1:])
+	case command == "login":
+		return a.login(ctx, args)
+	case command == "account" && sub == "login":
+		return a.login(ctx, args[1:])
+	case command == "help" || command == "-h" || command == "-help" || command == "--help":
+		fmt.Fprint(a.stdout, usage)
+		return nil
+	}
+	return fmt.Errorf("Unknown command %q\n%v", command+" "+sub, usage)
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/koffeinsource/go-imgur"
+	"github.com/koffeinsource/go-imgur/imgurtest"
+	"github.com/stretchr/testify/require"
+)
+
+var pngData = []byte("\x89PNG\r\n\x1a\n cat")
+
+// testApp returns an app using srv, configured in a temporary directory
+func testApp(t *testing.T, srv *imgurtest.Server, env map[string]string) (*app, *bytes.Buffer) {
+	dir := t.TempDir()
+	cfg, _ := json.Marshal(config{ClientID: "imgurtest", BaseURL: srv.URL + "/3/"})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.json"), cfg, 0600))
+
+	out := &bytes.Buffer{}
+	return &app{
+		getenv: func(key string) string {
+			if key == "IMGUR_CONFIG_DIR" {
+				return dir
+			}
+			return env[key]
+		},
+		stdin:  strings.NewReader(""),
+		stdout: out,
+		stderr: out,
+	}, out
+}
+
+func TestUploadAndAnonymousAlbum(t *testing.T) {
+	srv := imgurtest.NewServer()
+	defer srv.Close()
+	a, out := testApp(t, srv, nil)
+
+	file := filepath.Join(t.TempDir(), "cat.png")
+	require.NoError(t, os.WriteFile(file, pngData, 0600))
+	require.NoError(t, a.run(context.Background(), []string{"upload", "-title", "Cat", file}))
+
+	fields := strings.Fields(out.String())
+	require.Len(t, fields, 3)
+	require.Equal(t, "deletehash", fields[1])
+	require.Equal(t, 1, srv.Images())
+
+	out.Reset()
+	require.NoError(t, a.run(context.Background(), []string{"album", "create", "-title", "Cats", fields[2]}))
+	album := strings.Fields(out.String())
+	require.Len(t, album, 3)
+
+	require.NoError(t, a.run(context.Background(), []string{"upload", "-album", album[2], file}))
+	id := strings.TrimPrefix(album[0], "https://imgur.com/a/")
+	info, ok := srv.Album(imgur.AlbumID(id))
+	require.True(t, ok)
+	require.Equal(t, 2, info.ImagesCount)
+}
+
+func TestDownload(t *testing.T) {
+	srv := imgurtest.NewServer()
+	defer srv.Close()
+	a, out := testApp(t, srv, nil)
+
+	file := filepath.Join(t.TempDir(), "cat.png")
+	require.NoError(t, os.WriteFile(file, pngData, 0600))
+	require.NoError(t, a.run(context.Background(), []string{"upload", file}))
+	// the links of the Server are not imgur links, so the image is given by its ID
+	id := strings.TrimSuffix(path.Base(strings.Fields(out.String())[0]), ".png")
+
+	out.Reset()
+	dir := t.TempDir()
+	require.NoError(t, a.run(context.Background(), []string{"download", "-o", dir, id}))
+	name := strings.TrimSpace(out.String())
+	data, err := os.ReadFile(name)
+	require.NoError(t, err)
+	require.Equal(t, pngData, data)
+}
+
+func TestCommandErrors(t *testing.T) {
+	srv := imgurtest.NewServer()
+	defer srv.Close()
+	a, _ := testApp(t, srv, nil)
+
+	require.Error(t, a.run(context.Background(), nil))
+	require.Error(t, a.run(context.Background(), []string{"album", "remove"}))
+	require.Error(t, a.run(context.Background(), []string{"upload"}))
+	require.Error(t, a.run(context.Background(), []string{"album", "add", "hash"}))
+	// logging in needs the client secret
+	require.Error(t, a.run(context.Background(), []string{"account", "login"}))
+	require.NoError(t, a.run(context.Background(), []string{"help"}))
+}
+
+func TestWatch(t *testing.T) {
+	srv := imgurtest.NewServer()
+	defer srv.Close()
+	a, out := testApp(t, srv, nil)
+
+	dir := t.TempDir()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- a.run(ctx, []string{"watch", dir}) }()
+	time.Sleep(50 * time.Millisecond)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cat.png"), pngData, 0600))
+
+	require.Eventually(t, func() bool { return srv.Images() == 1 }, 5*time.Second, 10*time.Millisecond)
+	cancel()
+	require.NoError(t, <-done)
+	require.Contains(t, out.String(), srv.URL)
+}
+
+func TestLogin(t *testing.T) {
+	srv := imgurtest.NewServer()
+	defer srv.Close()
+	tokens := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/oauth2/token", r.URL.Path)
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "pin", r.PostForm.Get("grant_type"))
+		require.Equal(t, "1234", r.PostForm.Get("pin"))
+		require.Equal(t, "secret", r.PostForm.Get("client_secret"))
+		fmt.Fprint(w, `{"access_token":"access","expires_in":3600,"token_type":"bearer","refresh_token":"refresh","account_id":42,"account_username":"Locker"}`)
+	}))
+	defer tokens.Close()
+
+	a, out := testApp(t, srv, map[string]string{"IMGUR_CLIENT_SECRET": "secret"})
+	cfg, err := a.loadConfig()
+	require.NoError(t, err)
+	cfg.OAuthURL = tokens.URL + "/oauth2/"
+	data, _ := json.Marshal(cfg)
+	require.NoError(t, os.WriteFile(filepath.Join(cfg.dir, "config.json"), data, 0600))
+
+	a.stdin = strings.NewReader("1234\n")
+	require.NoError(t, a.run(context.Background(), []string{"login"}))
+	require.Contains(t, out.String(), tokens.URL+"/oauth2/authorize?client_id=imgurtest&response_type=pin")
+	require.Contains(t, out.String(), "Logged in as Locker")
+
+	store, err := cfg.tokenStore()
+	require.NoError(t, err)
+	token, err := store.Load()
+	require.NoError(t, err)
+	require.Equal(t, "access", token.AccessToken)
+
+	// the stored token authenticates the next commands
+	s, err := a.newClient()
+	require.NoError(t, err)
+	require.True(t, s.user)
+}
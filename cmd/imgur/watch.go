@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/koffeinsource/go-imgur"
+	"github.com/koffeinsource/go-imgur/imgurwatch"
+)
+
+// watch uploads the files created in a directory until interrupted and prints their links
+func (a *app) watch(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("watch", flag.ContinueOnError)
+	album := fs.String("album", "", "Album to add the images to, the deletehash for anonymous albums")
+	clipboard := fs.Bool("clipboard", false, "Copy the link of every upload to the clipboard")
+	dirs, err := a.parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(dirs) != 1 {
+		return fmt.Errorf("One directory to watch is needed")
+	}
+	s, err := a.newClient()
+	if err != nil {
+		return err
+	}
+
+	var opts imgurwatch.Options
+	if *album != "" {
+		opts.Upload = append(opts.Upload, imgur.WithAlbum(s.albumRef(*album)))
+	}
+	err = imgurwatch.Watch(ctx, s.Client, dirs[0], opts, func(u imgurwatch.Upload) {
+		if u.Err != nil {
+			fmt.Fprintf(a.stderr, "Upload of %v failed: %v\n", u.Path, u.Err)
+			return
+		}
+		fmt.Fprintln(a.stdout, u.Image.Link)
+		if *clipboard {
+			if err := copyToClipboard(u.Image.Link); err != nil {
+				fmt.Fprintf(a.stderr, "Could not copy the link to the clipboard: %v\n", err)
+			}
+		}
+	})
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
+
+// clipboardCommands are the commands writing their input to the clipboard, the first
+// one found is used
+var clipboardCommands = [][]string{
+	{"wl-copy"},
+	{"xclip", "-selection", "clipboard"},
+	{"xsel", "--clipboard", "--input"},
+}
+
+// copyToClipboard puts text on the clipboard of the desktop
+func copyToClipboard(text string) error {
+	commands := clipboardCommands
+	switch runtime.GOOS {
+	case "darwin":
+		commands = [][]string{{"pbcopy"}}
+	case "windows":
+		commands = [][]string{{"clip"}}
+	}
+	for _, command := range commands {
+		if _, err := exec.LookPath(command[0]); err != nil {
+			continue
+		}
+		cmd := exec.Command(command[0], command[1:]...)
+		cmd.Stdin = strings.NewReader(text)
+		return cmd.Run()
+	}
+	return fmt.Errorf("No clipboard command found")
+}
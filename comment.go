@@ -1,20 +1,163 @@
 package imgur
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
 // Comment is an imgur comment
 type Comment struct {
-	ID         int       `json:"id"`          // The ID for the comment
-	ImageID    string    `json:"image_id"`    //The ID of the image that the comment is for
-	Comment    string    `json:"comment"`     // The comment itself.
-	Author     string    `json:"author"`      // Username of the author of the comment
-	AuthorID   int       `json:"author_id"`   // The account ID for the author
-	OnAlbum    bool      `json:"on_album"`    // If this comment was done to an album
-	AlbumCover string    `json:"album_cover"` // The ID of the album cover image, this is what should be displayed for album comments
-	Ups        int       `json:"ups"`         //	Number of upvotes for the comment
-	Downs      int       `json:"downs"`       // The number of downvotes for the comment
-	Points     float32   `json:"points"`      // the number of upvotes - downvotes
-	Datetime   int       `json:"datetime"`    // Timestamp of creation, epoch time
-	ParentID   int       `json:"parent_id"`   // If this is a reply, this will be the value of the comment_id for the caption this a reply for.
-	Deleted    bool      `json:"deleted"`     // Marked true if this caption has been deleted
-	Vote       string    `json:"vote"`        // The current user's vote on the comment. null if not signed in or if the user hasn't voted on it.
-	Children   []Comment `json:"children"`    // All of the replies for this comment. If there are no replies to the comment then this is an empty set.
+	ID         CommentID       `json:"id"`          // The ID for the comment
+	ImageID    string          `json:"image_id"`    //The ID of the image that the comment is for, see Post
+	Comment    string          `json:"comment"`     // The comment itself.
+	Author     string          `json:"author"`      // Username of the author of the comment
+	AuthorID   int             `json:"author_id"`   // The account ID for the author
+	OnAlbum    bool            `json:"on_album"`    // If this comment was done to an album
+	AlbumCover ImageID         `json:"album_cover"` // The ID of the album cover image, this is what should be displayed for album comments
+	Ups        int             `json:"ups"`         //	Number of upvotes for the comment
+	Downs      int             `json:"downs"`       // The number of downvotes for the comment
+	Points     float32         `json:"points"`      // the number of upvotes - downvotes
+	Datetime   UnixTime        `json:"datetime"`    // Timestamp of creation, epoch time
+	ParentID   CommentID       `json:"parent_id"`   // If this is a reply, this will be the value of the comment_id for the caption this a reply for.
+	Deleted    bool            `json:"deleted"`     // Marked true if this caption has been deleted
+	Vote       string          `json:"vote"`        // The current user's vote on the comment. null if not signed in or if the user hasn't voted on it.
+	Children   []Comment       `json:"children"`    // All of the replies for this comment. If there are no replies to the comment then this is an empty set.
+	Limit      *RateLimit      `json:"-"`           // Current rate limit, only set on the requested comment
+	Raw        json.RawMessage `json:"-"`           // The JSON sent by imgur, only kept with WithRawJSON on the requested comment
+}
+
+// CreatedAt returns when the comment was written
+func (c *Comment) CreatedAt() time.Time {
+	return c.Datetime.Time()
+}
+
+// Post returns the ImageID or AlbumID of the gallery post the comment is on
+func (c *Comment) Post() PostRef {
+	if c.OnAlbum {
+		return AlbumID(c.ImageID)
+	}
+	return ImageID(c.ImageID)
+}
+
+// GetComment queries imgur for a comment
+// returns the comment, status code of the request, error
+func (client *Client) GetComment(ctx context.Context, commentID CommentID) (*Comment, int, error) {
+	return client.getComment(ctx, "comment/"+commentID.String())
+}
+
+// GetCommentReplies queries imgur for a comment and the full tree of its replies in Children
+// returns the comment, status code of the request, error
+func (client *Client) GetCommentReplies(ctx context.Context, commentID CommentID) (*Comment, int, error) {
+	return client.getComment(ctx, "comment/"+commentID.String()+"/replies")
+}
+
+func (client *Client) getComment(ctx context.Context, path string) (*Comment, int, error) {
+	comment := &Comment{}
+	rl, status, err := client.send(ctx, "GET", path, nil, comment)
+	if err != nil {
+		return nil, status, fmt.Errorf("Problem getting %v - %w", path, err)
+	}
+	comment.Limit = rl
+	return comment, status, nil
+}
+
+// CreateComment comments on an image or album, which requires an authenticated client.
+// returns the ID of the new comment, status code of the request, error
+func (client *Client) CreateComment(ctx context.Context, post PostRef, comment string) (CommentID, int, error) {
+	return client.postComment(ctx, "comment", post, comment)
+}
+
+// ReplyToComment replies to a comment on an image or album, which requires an authenticated client.
+// returns the ID of the new comment, status code of the request, error
+func (client *Client) ReplyToComment(ctx context.Context, parentID CommentID, post PostRef, comment string) (CommentID, int, error) {
+	return client.postComment(ctx, "comment/"+parentID.String(), post, comment)
+}
+
+func (client *Client) postComment(ctx context.Context, path string, post PostRef, comment string) (CommentID, int, error) {
+	imageID := refString(post)
+	if imageID == "" {
+		return 0, -1, fmt.Errorf("Image ID for the comment is empty")
+	}
+	if strings.TrimSpace(comment) == "" {
+		return 0, -1, fmt.Errorf("Comment is empty")
+	}
+	if !client.authenticated() {
+		return 0, -1, fmt.Errorf("Commenting on %v requires an access token - %w", imageID, ErrUnauthorized)
+	}
+
+	var created struct {
+		ID CommentID `json:"id"`
+	}
+	v := url.Values{"image_id": {imageID}, "comment": {comment}}
+	_, status, err := client.send(ctx, "POST", path, v, &created)
+	if err != nil {
+		return 0, status, fmt.Errorf("Problem commenting on %v - %w", imageID, err)
+	}
+	return created.ID, status, nil
+}
+
+// DeleteComment deletes a comment of the user.
+// returns status code of the request, error
+func (client *Client) DeleteComment(ctx context.Context, commentID CommentID) (int, error) {
+	if !client.authenticated() {
+		return -1, fmt.Errorf("Deleting comment %v requires an access token - %w", commentID, ErrUnauthorized)
+	}
+	_, status, err := client.send(ctx, "DELETE", "comment/"+commentID.String(), nil, nil)
+	if err != nil {
+		return status, fmt.Errorf("Problem deleting comment %v - %w", commentID, err)
+	}
+	return status, nil
+}
+
+// ReportReason is the reason for reporting content to imgur
+type ReportReason int
+
+// Reasons for reporting content
+const (
+	ReportOffTopic    ReportReason = 1 // Doesn't belong on imgur
+	ReportSpam        ReportReason = 2
+	ReportAbusive     ReportReason = 3
+	ReportMature      ReportReason = 4 // Mature content that is not marked as mature
+	ReportPornography ReportReason = 5
+)
+
+// VoteComment votes on a comment, which requires an authenticated client.
+// Only VoteUp and VoteDown are accepted.
+// returns status code of the request, error
+func (client *Client) VoteComment(ctx context.Context, commentID CommentID, vote Vote) (int, error) {
+	if vote != VoteUp && vote != VoteDown {
+		return -1, fmt.Errorf("Invalid comment vote %q", vote)
+	}
+	if !client.authenticated() {
+		return -1, fmt.Errorf("Voting on comment %v requires an access token - %w", commentID, ErrUnauthorized)
+	}
+
+	_, status, err := client.send(ctx, "POST", "comment/"+commentID.String()+"/vote/"+string(vote), nil, nil)
+	if err != nil {
+		return status, fmt.Errorf("Problem voting on comment %v - %w", commentID, err)
+	}
+	return status, nil
+}
+
+// ReportComment reports a comment to the imgur moderators, which requires an authenticated client.
+// returns status code of the request, error
+func (client *Client) ReportComment(ctx context.Context, commentID CommentID, reason ReportReason) (int, error) {
+	if reason < ReportOffTopic || reason > ReportPornography {
+		return -1, fmt.Errorf("Invalid report reason %v", reason)
+	}
+	if !client.authenticated() {
+		return -1, fmt.Errorf("Reporting comment %v requires an access token - %w", commentID, ErrUnauthorized)
+	}
+
+	v := url.Values{"reason": {strconv.Itoa(int(reason))}}
+	_, status, err := client.send(ctx, "POST", "comment/"+commentID.String()+"/report", v, nil)
+	if err != nil {
+		return status, fmt.Errorf("Problem reporting comment %v - %w", commentID, err)
+	}
+	return status, nil
 }
@@ -0,0 +1,110 @@
+package imgur
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const commentTreeJSON = `{"data":{"id":1,"image_id":"ClF8rLe","comment":"Nice cat","author":"Locker","points":3,"children":[{"id":2,"parent_id":1,"comment":"Agreed","author":"other","children":[{"id":3,"parent_id":2,"comment":"Me too","children":[]}]}]},"success":true,"status":200}`
+
+func TestGetComment(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Contains(t, []string{"/3/comment/1", "/3/comment/1/replies"}, r.URL.Path)
+		fmt.Fprint(w, commentTreeJSON)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	comment, status, err := client.GetComment(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Equal(t, "Nice cat", comment.Comment)
+	require.Equal(t, "Locker", comment.Author)
+
+	comment, _, err = client.GetCommentReplies(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, comment.Children, 1)
+	require.Equal(t, "Me too", comment.Children[0].Children[0].Comment)
+}
+
+func TestCreateComment(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "POST", r.Method)
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "ClF8rLe", r.PostForm.Get("image_id"))
+		switch r.URL.Path {
+		case "/3/comment":
+			require.Equal(t, "Nice cat", r.PostForm.Get("comment"))
+			fmt.Fprint(w, `{"data":{"id":1},"success":true,"status":200}`)
+		case "/3/comment/1":
+			require.Equal(t, "Agreed", r.PostForm.Get("comment"))
+			fmt.Fprint(w, `{"data":{"id":2},"success":true,"status":200}`)
+		default:
+			t.Errorf("unexpected request to %v", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	id, status, err := client.CreateComment(context.Background(), ImageID("ClF8rLe"), "Nice cat")
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Equal(t, CommentID(1), id)
+
+	id, _, err = client.ReplyToComment(context.Background(), 1, ImageID("ClF8rLe"), "Agreed")
+	require.NoError(t, err)
+	require.Equal(t, CommentID(2), id)
+
+	_, _, err = client.CreateComment(context.Background(), ImageID("ClF8rLe"), "")
+	require.Error(t, err)
+
+	anonymous, _ := NewClient(httpC, "testing", "")
+	_, _, err = anonymous.CreateComment(context.Background(), ImageID("ClF8rLe"), "Nice cat")
+	require.True(t, errors.Is(err, ErrUnauthorized))
+}
+
+func TestDeleteComment(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "DELETE", r.Method)
+		require.Equal(t, "/3/comment/1", r.URL.Path)
+		fmt.Fprint(w, `{"data":true,"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	status, err := client.DeleteComment(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+}
+
+func TestVoteAndReportComment(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "POST", r.Method)
+		switch r.URL.Path {
+		case "/3/comment/1/vote/down":
+		case "/3/comment/1/report":
+			require.NoError(t, r.ParseForm())
+			require.Equal(t, "2", r.PostForm.Get("reason"))
+		default:
+			t.Errorf("unexpected request to %v", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"data":true,"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	_, err := client.VoteComment(context.Background(), 1, VoteDown)
+	require.NoError(t, err)
+	_, err = client.VoteComment(context.Background(), 1, VoteVeto)
+	require.Error(t, err)
+
+	_, err = client.ReportComment(context.Background(), 1, ReportSpam)
+	require.NoError(t, err)
+	_, err = client.ReportComment(context.Background(), 1, ReportReason(9))
+	require.Error(t, err)
+}
@@ -0,0 +1,86 @@
+package imgur
+
+import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// compressionTransport negotiates gzip and deflate compressed responses and decompresses
+// them, whatever transport the http.Client uses. Requests that negotiate an encoding
+// themselves or ask for a range are passed on unchanged, like http.Transport does.
+// The MaxResponseSize applies to the decompressed body, as it is read after this layer.
+// Responses are decompressed while they are read, so listings, e.g. of GetAccountImages
+// or the gallery, are decoded item by item from the compressed stream by decodeStream.
+type compressionTransport struct {
+	next http.RoundTripper
+}
+
+func (t *compressionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Accept-Encoding") != "" || req.Header.Get("Range") != "" || req.Method == http.MethodHead {
+		return t.next.RoundTrip(req)
+	}
+	// a RoundTripper must not change the request it was given
+	req = req.Clone(req.Context())
+	req.Header.Set("Accept-Encoding", "gzip, deflate")
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		return res, err
+	}
+
+	var decode func(*bufio.Reader) (io.Reader, error)
+	switch strings.ToLower(strings.TrimSpace(res.Header.Get("Content-Encoding"))) {
+	case "gzip", "x-gzip":
+		decode = func(r *bufio.Reader) (io.Reader, error) { return gzip.NewReader(r) }
+	case "deflate":
+		decode = inflate
+	default:
+		return res, nil
+	}
+	res.Body = &decodedBody{body: res.Body, decode: decode}
+	res.Header.Del("Content-Encoding")
+	res.Header.Del("Content-Length")
+	res.ContentLength = -1
+	res.Uncompressed = true
+	return res, nil
+}
+
+// inflate decodes a deflate encoded body, which should be wrapped in zlib, but some
+// servers send the raw deflate stream
+func inflate(r *bufio.Reader) (io.Reader, error) {
+	header, err := r.Peek(2)
+	if err != nil {
+		return nil, err
+	}
+	if header[0]&0x0f == 8 && (uint16(header[0])<<8|uint16(header[1]))%31 == 0 {
+		return zlib.NewReader(r)
+	}
+	return flate.NewReader(r), nil
+}
+
+// decodedBody decompresses a response body. The decoder is created on the first read,
+// as it reads the header of the body and empty bodies, e.g. of a 304 response, have none.
+type decodedBody struct {
+	body   io.ReadCloser
+	decode func(*bufio.Reader) (io.Reader, error)
+	r      io.Reader
+	err    error
+}
+
+func (b *decodedBody) Read(p []byte) (int, error) {
+	if b.r == nil && b.err == nil {
+		b.r, b.err = b.decode(bufio.NewReader(b.body))
+	}
+	if b.err != nil {
+		return 0, b.err
+	}
+	return b.r.Read(p)
+}
+
+func (b *decodedBody) Close() error {
+	return b.body.Close()
+}
@@ -0,0 +1,113 @@
+package imgur
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressedResponses(t *testing.T) {
+	items := `[` + strings.TrimSuffix(strings.Repeat(`{"id":"ClF8rLe","title":"cat","is_album":false},`, 1000), ",") + `]`
+	body := `{"data":` + items + `,"success":true,"status":200}`
+	encoders := map[string]func(io.Writer) io.WriteCloser{
+		"gzip":    func(w io.Writer) io.WriteCloser { return gzip.NewWriter(w) },
+		"deflate": func(w io.Writer) io.WriteCloser { return zlib.NewWriter(w) },
+		"raw":     func(w io.Writer) io.WriteCloser { fw, _ := flate.NewWriter(w, flate.DefaultCompression); return fw },
+	}
+
+	var sent int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "gzip, deflate", r.Header.Get("Accept-Encoding"))
+		encoding := strings.TrimPrefix(r.URL.Path, "/3/gallery/")
+		var buf bytes.Buffer
+		enc := encoders[encoding]
+		ew := enc(&buf)
+		fmt.Fprint(ew, body)
+		ew.Close()
+		sent = buf.Len()
+		if encoding == "raw" {
+			encoding = "deflate"
+		}
+		w.Header().Set("Content-Encoding", encoding)
+		w.Write(buf.Bytes())
+	}))
+	defer server.Close()
+
+	// the transport does not negotiate compression itself
+	httpC := &http.Client{Transport: roundTripperFunc(server.Client().Transport.RoundTrip)}
+	client, _ := New("testing", WithHTTPClient(httpC), WithBaseURL(server.URL+"/3/"), WithMaxResponseSize(int64(len(body))))
+	for encoding := range encoders {
+		var decoded []GalleryItem
+		_, status, err := client.Do(context.Background(), "GET", "gallery/"+encoding, nil, &decoded)
+		require.NoError(t, err, encoding)
+		require.Equal(t, 200, status, encoding)
+		require.Len(t, decoded, 1000, encoding)
+		require.Equal(t, ImageID("ClF8rLe"), decoded[999].AsImage().ID, encoding)
+		require.Less(t, sent, len(body)/10, encoding)
+	}
+}
+
+func TestCompressionDisabled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "", r.Header.Get("Accept-Encoding"))
+		fmt.Fprint(w, `{"data":{"id":"ClF8rLe"},"success":true,"status":200}`)
+	}))
+	defer server.Close()
+
+	httpC := &http.Client{Transport: &http.Transport{DisableCompression: true}}
+	client, _ := New("testing", WithHTTPClient(httpC), WithBaseURL(server.URL+"/3/"))
+	_, _, err := client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+}
+
+func TestCompressedEmptyBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	res, err := (&compressionTransport{next: http.DefaultTransport}).RoundTrip(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	b, err := ioutil.ReadAll(res.Body)
+	require.NoError(t, err)
+	require.Empty(t, b)
+}
+
+func BenchmarkCompressedListing(b *testing.B) {
+	items := strings.TrimSuffix(strings.Repeat(`{"id":"ClF8rLe","title":"`+strings.Repeat("cat", 100)+`","type":"image/png"},`, 1000), ",")
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	fmt.Fprint(gw, `{"data":[`+items+`],"success":true,"status":200}`)
+	gw.Close()
+	httpC := &http.Client{Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/json"}, "Content-Encoding": {"gzip"}},
+			Body:       ioutil.NopCloser(bytes.NewReader(buf.Bytes())),
+			Request:    r,
+		}, nil
+	})}
+	client, _ := New("testing", WithHTTPClient(httpC), WithAccessToken("access"))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		images, _, err := client.GetAccountImages(context.Background(), "me", 0)
+		if err != nil || len(images) != 1000 {
+			b.Fatal(err, len(images))
+		}
+	}
+}
@@ -1,7 +1,16 @@
 package imgur
 
+// Version is the version of the library, it is part of DefaultUserAgent
+const Version = "0.1.0"
+
+// DefaultUserAgent identifies the library to imgur, it is sent unless WithUserAgent is used
+const DefaultUserAgent = "go-imgur/" + Version + " (+https://github.com/koffeinsource/go-imgur)"
+
 const (
-	apiEndpoint                    = "https://api.imgur.com/3/"
-	apiEndpointRapidAPI            = "https://imgur-apiv3.p.rapidapi.com/3/"
-	apiEndpointGenerateAccessToken = "https://api.imgur.com/oauth2/token"
+	apiEndpoint         = "https://api.imgur.com/3/"
+	apiEndpointRapidAPI = "https://" + rapidAPIHost + "/3/"
+	apiEndpointRoot     = "https://api.imgur.com/"
+
+	// rapidAPIHost is the host of the imgur API on RapidAPI, sent in the x-rapidapi-host header
+	rapidAPIHost = "imgur-apiv3.p.rapidapi.com"
 )
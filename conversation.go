@@ -0,0 +1,131 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Conversation is a thread of direct messages with another user
+type Conversation struct {
+	ID                 int        `json:"id"`                   // The ID of the conversation
+	LastMessagePreview string     `json:"last_message_preview"` // The beginning of the last message
+	Datetime           UnixTime   `json:"datetime"`             // Time of the last message, epoch time
+	WithAccountID      int        `json:"with_account_id"`      // The account ID of the other user
+	WithAccount        string     `json:"with_account"`         // The username of the other user
+	MessageCount       int        `json:"message_count"`        // Total number of messages in the conversation
+	Messages           []Message  `json:"messages,omitempty"`   // The messages of the requested page, only set by GetConversation
+	Done               bool       `json:"done"`                 // True if the page contains the oldest messages
+	Page               int        `json:"page"`                 // The requested page
+	Limit              *RateLimit `json:"-"`                    // Current rate limit
+}
+
+// LastMessageAt returns when the last message of the conversation was sent
+func (c *Conversation) LastMessageAt() time.Time {
+	return c.Datetime.Time()
+}
+
+// Message is a direct message in a conversation
+type Message struct {
+	ID             int      `json:"id"`              // The ID of the message
+	From           string   `json:"from"`            // The username of the sender
+	AccountID      int      `json:"account_id"`      // The account ID of the recipient
+	SenderID       int      `json:"sender_id"`       // The account ID of the sender
+	Body           string   `json:"body"`            // The text of the message
+	ConversationID int      `json:"conversation_id"` // The ID of the conversation of the message
+	Datetime       UnixTime `json:"datetime"`        // Time the message was sent, epoch time
+}
+
+// SentAt returns when the message was sent
+func (m *Message) SentAt() time.Time {
+	return m.Datetime.Time()
+}
+
+// conversations requests a conversation endpoint, which always requires an authenticated client
+func (client *Client) conversations(ctx context.Context, method string, path string, params url.Values, v interface{}) (*RateLimit, int, error) {
+	if !client.authenticated() {
+		return nil, -1, fmt.Errorf("Messaging requires an access token - %w", ErrUnauthorized)
+	}
+	return client.send(ctx, method, path, params, v)
+}
+
+// ListConversations lists the conversations of the user, without their messages.
+// returns the conversations, status code of the request, error
+func (client *Client) ListConversations(ctx context.Context) ([]Conversation, int, error) {
+	var conversations []Conversation
+	_, status, err := client.conversations(ctx, "GET", "conversations", nil, &conversations)
+	if err != nil {
+		return nil, status, fmt.Errorf("Problem listing conversations - %w", err)
+	}
+	return conversations, status, nil
+}
+
+// GetConversation queries imgur for a page of the messages of a conversation, starting at page 1.
+// returns the conversation, status code of the request, error
+func (client *Client) GetConversation(ctx context.Context, conversationID int, page int) (*Conversation, int, error) {
+	if page < 1 {
+		page = 1
+	}
+
+	conversation := &Conversation{}
+	path := "conversations/" + strconv.Itoa(conversationID) + "/" + strconv.Itoa(page)
+	rl, status, err := client.conversations(ctx, "GET", path, nil, conversation)
+	if err != nil {
+		return nil, status, fmt.Errorf("Problem getting conversation %v - %w", conversationID, err)
+	}
+	conversation.Limit = rl
+	return conversation, status, nil
+}
+
+// SendMessage sends a direct message to recipient, starting a new conversation if needed.
+// returns status code of the request, error
+func (client *Client) SendMessage(ctx context.Context, recipient string, body string) (int, error) {
+	if strings.TrimSpace(recipient) == "" {
+		return -1, fmt.Errorf("Recipient is empty")
+	}
+	if strings.TrimSpace(body) == "" {
+		return -1, fmt.Errorf("Message is empty")
+	}
+
+	_, status, err := client.conversations(ctx, "POST", "conversations/"+recipient, url.Values{"body": {body}}, nil)
+	if err != nil {
+		return status, fmt.Errorf("Problem sending message to %v - %w", recipient, err)
+	}
+	return status, nil
+}
+
+// DeleteConversation deletes a conversation of the user.
+// returns status code of the request, error
+func (client *Client) DeleteConversation(ctx context.Context, conversationID int) (int, error) {
+	_, status, err := client.conversations(ctx, "DELETE", "conversations/"+strconv.Itoa(conversationID), nil, nil)
+	if err != nil {
+		return status, fmt.Errorf("Problem deleting conversation %v - %w", conversationID, err)
+	}
+	return status, nil
+}
+
+// ReportSender reports username for abusing direct messages.
+// returns status code of the request, error
+func (client *Client) ReportSender(ctx context.Context, username string) (int, error) {
+	return client.handleSender(ctx, "report", username)
+}
+
+// BlockSender blocks username from sending direct messages to the user.
+// returns status code of the request, error
+func (client *Client) BlockSender(ctx context.Context, username string) (int, error) {
+	return client.handleSender(ctx, "block", username)
+}
+
+func (client *Client) handleSender(ctx context.Context, action string, username string) (int, error) {
+	if strings.TrimSpace(username) == "" {
+		return -1, fmt.Errorf("Username to %v is empty", action)
+	}
+	_, status, err := client.conversations(ctx, "POST", "conversations/"+action+"/"+username, nil, nil)
+	if err != nil {
+		return status, fmt.Errorf("Problem with %v of sender %v - %w", action, username, err)
+	}
+	return status, nil
+}
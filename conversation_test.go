@@ -0,0 +1,77 @@
+package imgur
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConversations(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer access", r.Header.Get("Authorization"))
+		switch r.Method + " " + r.URL.Path {
+		case "GET /3/conversations":
+			fmt.Fprint(w, `{"data":[{"id":12,"last_message_preview":"Hi","datetime":1460715031,"with_account_id":1,"with_account":"friend","message_count":2}],"success":true,"status":200}`)
+		case "GET /3/conversations/12/1":
+			fmt.Fprint(w, `{"data":{"id":12,"with_account":"friend","message_count":2,"messages":[{"id":1,"from":"friend","body":"Hi","conversation_id":12},{"id":2,"from":"Locker","body":"Hello","conversation_id":12}],"done":true,"page":1},"success":true,"status":200}`)
+		case "POST /3/conversations/friend":
+			require.NoError(t, r.ParseForm())
+			require.Equal(t, "How are you?", r.PostForm.Get("body"))
+			fmt.Fprint(w, `{"data":true,"success":true,"status":200}`)
+		case "DELETE /3/conversations/12":
+			fmt.Fprint(w, `{"data":true,"success":true,"status":200}`)
+		default:
+			t.Errorf("unexpected request %v %v", r.Method, r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	conversations, status, err := client.ListConversations(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Len(t, conversations, 1)
+	require.Equal(t, "friend", conversations[0].WithAccount)
+
+	conversation, _, err := client.GetConversation(context.Background(), 12, 0)
+	require.NoError(t, err)
+	require.True(t, conversation.Done)
+	require.Len(t, conversation.Messages, 2)
+	require.Equal(t, "Hello", conversation.Messages[1].Body)
+
+	_, err = client.SendMessage(context.Background(), "friend", "How are you?")
+	require.NoError(t, err)
+	_, err = client.SendMessage(context.Background(), "friend", "")
+	require.Error(t, err)
+
+	_, err = client.DeleteConversation(context.Background(), 12)
+	require.NoError(t, err)
+
+	anonymous, _ := NewClient(httpC, "testing", "")
+	_, _, err = anonymous.ListConversations(context.Background())
+	require.True(t, errors.Is(err, ErrUnauthorized))
+}
+
+func TestReportAndBlockSender(t *testing.T) {
+	var paths []string
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "POST", r.Method)
+		paths = append(paths, r.URL.Path)
+		fmt.Fprint(w, `{"data":true,"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	_, err := client.ReportSender(context.Background(), "spammer")
+	require.NoError(t, err)
+	_, err = client.BlockSender(context.Background(), "spammer")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/3/conversations/report/spammer", "/3/conversations/block/spammer"}, paths)
+
+	_, err = client.BlockSender(context.Background(), "")
+	require.Error(t, err)
+}
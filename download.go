@@ -0,0 +1,207 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DownloadImage queries imgur for an image and opens its file. idOrURL is an image ID
+// or any imgur link to an image, see ParseURL. The caller has to close the returned reader.
+// returns the file content, image info, error
+func (client *Client) DownloadImage(ctx context.Context, idOrURL string) (io.ReadCloser, *ImageInfo, error) {
+	id := ImageID(strings.TrimSpace(idOrURL))
+	if strings.ContainsAny(string(id), "/.") {
+		parsed, err := ParseURL(string(id))
+		if err != nil {
+			return nil, nil, err
+		}
+		if parsed.Kind == URLAlbum {
+			return nil, nil, fmt.Errorf("Link %v points to an album, not an image", idOrURL)
+		}
+		id = ImageID(parsed.ID)
+	}
+	if id == "" {
+		return nil, nil, fmt.Errorf("Image ID is empty")
+	}
+
+	img, _, err := client.GetImageInfoWithContext(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	res, err := client.openFile(ctx, img.DirectURL(), 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	return res.Body, img, nil
+}
+
+// openFile requests the file at link, starting at offset if it is > 0. The file
+// hosts are not part of the API, so the request carries no credentials.
+func (client *Client) openFile(ctx context.Context, link string, offset int64) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", link, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Problem creating request for %v - %w", link, err)
+	}
+	req.Header.Set("User-Agent", client.userAgent)
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	res, err := client.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Could not download %v - %w", link, err)
+	}
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusPartialContent {
+		discardBody(res)
+		return nil, fmt.Errorf("Could not download %v - %v", link, res.Status)
+	}
+	return res, nil
+}
+
+// DownloadOptions configures DownloadAlbum
+type DownloadOptions struct {
+	Concurrency int  // Number of parallel downloads, defaults to 4
+	Overwrite   bool // Download images again instead of skipping or resuming existing files
+	// NamePattern is the file name of each image. {index} is replaced by the position in the
+	// album, {id} by the image ID and {ext} by the file extension. Defaults to "{index}-{id}{ext}".
+	NamePattern string
+}
+
+const defaultDownloadConcurrency = 4
+
+// DownloadAlbum downloads all images of an album into destDir, which is created if needed.
+// Existing files are skipped if they are complete and resumed otherwise, unless opts.Overwrite is set.
+// returns album info, error
+func (client *Client) DownloadAlbum(ctx context.Context, albumID AlbumID, destDir string, opts DownloadOptions) (*AlbumInfo, error) {
+	album, _, err := client.GetAlbumInfoWithContext(ctx, albumID)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(destDir, 0755); err != nil {
+		return nil, fmt.Errorf("Problem creating directory %v - %w", destDir, err)
+	}
+
+	workers := opts.Concurrency
+	if workers <= 0 {
+		workers = defaultDownloadConcurrency
+	}
+	if workers > len(album.Images) {
+		workers = len(album.Images)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	indices := make(chan int)
+	errs := make(chan error, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				img := &album.Images[i]
+				name := downloadName(opts.NamePattern, i, len(album.Images), img)
+				if err := client.downloadFile(ctx, img, filepath.Join(destDir, name), opts.Overwrite); err != nil {
+					errs <- err
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+
+feed:
+	for i := range album.Images {
+		select {
+		case indices <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(indices)
+	wg.Wait()
+	close(errs)
+
+	if err := <-errs; err != nil {
+		return nil, fmt.Errorf("Problem downloading album %v - %w", albumID, err)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return album, nil
+}
+
+// downloadName returns the file name of the image at index i of an album with n images
+func downloadName(pattern string, i int, n int, img *ImageInfo) string {
+	if pattern == "" {
+		pattern = "{index}-{id}{ext}"
+	}
+	// pad the index so the files sort in album order
+	index := fmt.Sprintf("%0*d", len(strconv.Itoa(n)), i+1)
+	return strings.NewReplacer(
+		"{index}", index,
+		"{id}", string(img.ID),
+		"{ext}", path.Ext(img.DirectURL()),
+	).Replace(pattern)
+}
+
+// downloadFile stores the file of img at name. A shorter existing file is resumed with a Range request.
+func (client *Client) downloadFile(ctx context.Context, img *ImageInfo, name string, overwrite bool) error {
+	var offset int64
+	if info, err := os.Stat(name); err == nil && !overwrite {
+		if img.Size <= 0 || info.Size() >= int64(img.Size) {
+			client.Log.Debugf("Skipping existing file %v", name)
+			return nil
+		}
+		offset = info.Size()
+	}
+
+	res, err := client.openFile(ctx, img.DirectURL(), offset)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	flags := os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	// the file host may ignore the range and send the whole file
+	if offset == 0 || res.StatusCode != http.StatusPartialContent {
+		flags = os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	}
+	f, err := os.OpenFile(name, flags, 0644)
+	if err != nil {
+		return fmt.Errorf("Problem opening %v - %w", name, err)
+	}
+	if _, err := io.Copy(f, res.Body); err != nil {
+		f.Close()
+		return fmt.Errorf("Problem writing %v - %w", name, err)
+	}
+	return f.Close()
+}
+
+// DownloadAlbumZip writes the zip archive imgur creates for an album to w.
+// progress may be nil, the total passed to it is -1 while imgur does not announce the size.
+// returns the number of bytes written, error
+func (client *Client) DownloadAlbumZip(ctx context.Context, albumID AlbumID, w io.Writer, progress ProgressFunc) (int64, error) {
+	if refString(albumID) == "" {
+		return 0, fmt.Errorf("Album ID is empty")
+	}
+	res, err := client.openFile(ctx, AlbumPageURL(albumID)+"/zip", 0)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	n, err := io.Copy(w, newProgressReader(res.Body, res.ContentLength, progress))
+	if err != nil {
+		return n, fmt.Errorf("Problem downloading zip of album %v - %w", albumID, err)
+	}
+	return n, nil
+}
@@ -0,0 +1,158 @@
+package imgur
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloadImage(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Host {
+		case "api.imgur.com":
+			require.Equal(t, "/3/image/ClF8rLe", r.URL.Path)
+			fmt.Fprint(w, `{"data":{"id":"ClF8rLe","type":"image/png","link":"https://i.imgur.com/ClF8rLe.png"},"success":true,"status":200}`)
+		case "i.imgur.com":
+			require.Equal(t, "/ClF8rLe.png", r.URL.Path)
+			require.Empty(t, r.Header.Get("Authorization"))
+			fmt.Fprint(w, "png data")
+		default:
+			t.Errorf("Unexpected host %v", r.Host)
+		}
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	for _, idOrURL := range []string{"ClF8rLe", "https://imgur.com/ClF8rLe", "https://i.imgur.com/ClF8rLe.png"} {
+		body, img, err := client.DownloadImage(context.Background(), idOrURL)
+		require.NoError(t, err)
+		require.Equal(t, ImageID("ClF8rLe"), img.ID)
+		data, err := io.ReadAll(body)
+		require.NoError(t, err)
+		require.NoError(t, body.Close())
+		require.Equal(t, "png data", string(data))
+	}
+
+	_, _, err := client.DownloadImage(context.Background(), "https://imgur.com/a/VZQXk")
+	require.Error(t, err)
+	_, _, err = client.DownloadImage(context.Background(), " ")
+	require.Error(t, err)
+}
+
+func TestDownloadImageFileError(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		if r.Host == "i.imgur.com" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"id":"ClF8rLe","link":"https://i.imgur.com/ClF8rLe.png"},"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	_, _, err := client.DownloadImage(context.Background(), "ClF8rLe")
+	require.Error(t, err)
+}
+
+func TestDownloadAlbum(t *testing.T) {
+	files := map[string]string{
+		"/a.png": "first image",
+		"/b.jpg": "second image data",
+		"/c.gif": "third",
+	}
+	var ranges sync.Map
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		if r.Host == "api.imgur.com" {
+			require.Equal(t, "/3/album/VZQXk", r.URL.Path)
+			fmt.Fprint(w, `{"data":{"id":"VZQXk","images":[`+
+				`{"id":"a","size":11,"link":"https://i.imgur.com/a.png"},`+
+				`{"id":"b","size":17,"link":"https://i.imgur.com/b.jpg"},`+
+				`{"id":"c","size":5,"link":"https://i.imgur.com/c.gif"}]},"success":true,"status":200}`)
+			return
+		}
+		content, ok := files[r.URL.Path]
+		require.True(t, ok, r.URL.Path)
+		ranges.Store(r.URL.Path, r.Header.Get("Range"))
+		http.ServeContent(w, r, r.URL.Path, time.Time{}, strings.NewReader(content))
+	})
+	defer server.Close()
+
+	dir := t.TempDir()
+	// a complete file, a partial file and a missing file
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "1-a.png"), []byte("first image"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "2-b.jpg"), []byte("second"), 0644))
+
+	client, _ := NewClient(httpC, "testing", "")
+	album, err := client.DownloadAlbum(context.Background(), "VZQXk", dir, DownloadOptions{Concurrency: 2})
+	require.NoError(t, err)
+	require.Len(t, album.Images, 3)
+
+	for name, content := range map[string]string{"1-a.png": "first image", "2-b.jpg": "second image data", "3-c.gif": "third"} {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		require.NoError(t, err)
+		require.Equal(t, content, string(data))
+	}
+	_, requested := ranges.Load("/a.png")
+	require.False(t, requested)
+	rng, _ := ranges.Load("/b.jpg")
+	require.Equal(t, "bytes=6-", rng)
+	rng, _ = ranges.Load("/c.gif")
+	require.Equal(t, "", rng)
+
+	_, err = client.DownloadAlbum(context.Background(), "VZQXk", dir, DownloadOptions{Overwrite: true, NamePattern: "{id}{ext}"})
+	require.NoError(t, err)
+	rng, _ = ranges.Load("/a.png")
+	require.Equal(t, "", rng)
+	_, err = os.Stat(filepath.Join(dir, "a.png"))
+	require.NoError(t, err)
+}
+
+func TestDownloadAlbumError(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		if r.Host == "i.imgur.com" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"id":"VZQXk","images":[{"id":"a","link":"https://i.imgur.com/a.png"}]},"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	_, err := client.DownloadAlbum(context.Background(), "VZQXk", t.TempDir(), DownloadOptions{})
+	require.Error(t, err)
+}
+
+func TestDownloadAlbumZip(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "imgur.com", r.Host)
+		require.Equal(t, "/a/VZQXk/zip", r.URL.Path)
+		w.Header().Set("Content-Length", "8")
+		fmt.Fprint(w, "zip data")
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	var buf bytes.Buffer
+	var sent, total int64
+	n, err := client.DownloadAlbumZip(context.Background(), "VZQXk", &buf, func(s, t int64) {
+		sent, total = s, t
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(8), n)
+	require.Equal(t, "zip data", buf.String())
+	require.Equal(t, int64(8), sent)
+	require.Equal(t, int64(8), total)
+
+	_, err = client.DownloadAlbumZip(context.Background(), "", &buf, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,141 @@
+package imgur
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Environment variables read by NewClientFromEnv
+const (
+	EnvClientID     = "IMGUR_CLIENT_ID"     // The client ID of the application, required
+	EnvClientSecret = "IMGUR_CLIENT_SECRET" // The client secret, required with EnvRefreshToken
+	EnvAccessToken  = "IMGUR_ACCESS_TOKEN"  // The OAuth access token of a user
+	EnvRefreshToken = "IMGUR_REFRESH_TOKEN" // The OAuth refresh token of a user, renews the access token
+	EnvRapidAPIKey  = "IMGUR_RAPIDAPI_KEY"  // Sends the requests through RapidAPI
+	EnvBaseURL      = "IMGUR_BASE_URL"      // Replaces the URL of the imgur API, see WithBaseURL
+)
+
+// NewClientFromEnv creates a client configured by the Env environment variables.
+// Without tokens, the client is anonymous. With EnvRefreshToken, the access token is
+// requested with the refresh token when needed and renewed once it expires, which
+// requires EnvClientSecret. opts are applied after the options of the environment.
+func NewClientFromEnv(opts ...ClientOption) (*Client, error) {
+	clientID := strings.TrimSpace(os.Getenv(EnvClientID))
+	if clientID == "" {
+		return nil, fmt.Errorf("%v is not set", EnvClientID)
+	}
+	secret := strings.TrimSpace(os.Getenv(EnvClientSecret))
+	accessToken := strings.TrimSpace(os.Getenv(EnvAccessToken))
+	refreshToken := strings.TrimSpace(os.Getenv(EnvRefreshToken))
+	if refreshToken != "" && secret == "" {
+		return nil, fmt.Errorf("%v needs %v to renew the access token", EnvRefreshToken, EnvClientSecret)
+	}
+
+	var envOpts []ClientOption
+	if baseURL := strings.TrimSpace(os.Getenv(EnvBaseURL)); baseURL != "" {
+		u, err := url.Parse(baseURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("%v %q is not an http or https URL", EnvBaseURL, baseURL)
+		}
+		envOpts = append(envOpts, WithBaseURL(baseURL))
+	}
+	if key := strings.TrimSpace(os.Getenv(EnvRapidAPIKey)); key != "" {
+		envOpts = append(envOpts, WithRapidAPIKey(key))
+	}
+	if accessToken != "" && refreshToken == "" {
+		envOpts = append(envOpts, WithAccessToken(accessToken))
+	}
+
+	client, err := New(clientID, append(envOpts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	// a token source passed in opts takes precedence
+	if refreshToken != "" && client.tokenSource == nil {
+		client.tokenSource = &refreshSource{
+			client: client,
+			secret: secret,
+			token:  &Token{AccessToken: accessToken, RefreshToken: refreshToken},
+		}
+	}
+	return client, nil
+}
+
+// refreshSource is the TokenSource of NewClientFromEnv, it renews the access token with
+// the refresh token
+type refreshSource struct {
+	client *Client
+	secret string
+
+	mu    sync.Mutex
+	token *Token
+}
+
+func (s *refreshSource) Token(ctx context.Context) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token.AccessToken != "" && (s.token.Expiry.IsZero() || time.Until(s.token.Expiry) > time.Minute) {
+		return s.token, nil
+	}
+	return s.refresh(ctx)
+}
+
+func (s *refreshSource) Refresh(ctx context.Context, expired *Token) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if expired != nil && s.token.AccessToken != expired.AccessToken {
+		// another request already refreshed the token
+		return s.token, nil
+	}
+	return s.refresh(ctx)
+}
+
+// refresh requests a new access token, s.mu has to be held. The request is sent with the
+// http.Client directly, as the token source authenticates the requests of the client.
+func (s *refreshSource) refresh(ctx context.Context) (*Token, error) {
+	grant := url.Values{
+		"client_id":     {s.client.imgurAccount.clientID},
+		"client_secret": {s.secret},
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {s.token.RefreshToken},
+	}
+	URL := s.client.createRootURL("oauth2/token")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, URL, strings.NewReader(grant.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("Could not create request for %v - %w", URL, err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("User-Agent", s.client.userAgent)
+
+	res, err := s.client.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Could not refresh access token - %w", err)
+	}
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("Problem reading the body of %v - %w", URL, err)
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return nil, fmt.Errorf("Could not refresh access token - %w", NewAPIError(req.Method, URL, res.StatusCode, body))
+	}
+
+	var response GenerateAccessTokenResponse
+	if err := json.Unmarshal(body, &response); err != nil || response.AccessToken == "" {
+		return nil, fmt.Errorf("Token response of %v contains no access token", URL)
+	}
+	token := response.Token()
+	if token.RefreshToken == "" {
+		token.RefreshToken = s.token.RefreshToken
+	}
+	s.token = token
+	return token, nil
+}
@@ -0,0 +1,86 @@
+package imgur
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// clearEnv unsets all variables read by NewClientFromEnv for the test
+func clearEnv(t *testing.T) {
+	for _, key := range []string{EnvClientID, EnvClientSecret, EnvAccessToken, EnvRefreshToken, EnvRapidAPIKey, EnvBaseURL} {
+		t.Setenv(key, "")
+	}
+}
+
+func TestNewClientFromEnv(t *testing.T) {
+	clearEnv(t)
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer access", r.Header.Get("Authorization"))
+		require.Equal(t, "key", r.Header.Get("x-rapidapi-key"))
+		fmt.Fprint(w, `{"data":{"id":"ClF8rLe"},"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	t.Setenv(EnvClientID, "testing")
+	t.Setenv(EnvAccessToken, "access")
+	t.Setenv(EnvRapidAPIKey, "key")
+	client, err := NewClientFromEnv(WithHTTPClient(httpC))
+	require.NoError(t, err)
+	_, _, err = client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+}
+
+func TestNewClientFromEnvRefreshToken(t *testing.T) {
+	clearEnv(t)
+	var refreshes int32
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth2/token" {
+			atomic.AddInt32(&refreshes, 1)
+			require.NoError(t, r.ParseForm())
+			require.Equal(t, "refresh_token", r.PostForm.Get("grant_type"))
+			require.Equal(t, "refresh", r.PostForm.Get("refresh_token"))
+			require.Equal(t, "secret", r.PostForm.Get("client_secret"))
+			fmt.Fprint(w, `{"access_token":"fresh","expires_in":3600,"token_type":"bearer"}`)
+			return
+		}
+		require.Equal(t, "Bearer fresh", r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"data":{"id":"ClF8rLe"},"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	t.Setenv(EnvClientID, "testing")
+	t.Setenv(EnvClientSecret, "secret")
+	t.Setenv(EnvRefreshToken, "refresh")
+	client, err := NewClientFromEnv(WithHTTPClient(httpC))
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		_, _, err = client.GetImageInfo("ClF8rLe")
+		require.NoError(t, err)
+	}
+	require.Equal(t, int32(1), atomic.LoadInt32(&refreshes))
+}
+
+func TestNewClientFromEnvInvalid(t *testing.T) {
+	clearEnv(t)
+	_, err := NewClientFromEnv()
+	require.Error(t, err)
+
+	t.Setenv(EnvClientID, "testing")
+	t.Setenv(EnvRefreshToken, "refresh")
+	_, err = NewClientFromEnv()
+	require.Error(t, err)
+
+	t.Setenv(EnvRefreshToken, "")
+	t.Setenv(EnvBaseURL, "localhost:8080")
+	_, err = NewClientFromEnv()
+	require.Error(t, err)
+
+	t.Setenv(EnvBaseURL, "http://localhost:8080/3/")
+	client, err := NewClientFromEnv()
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:8080/3/image", client.createAPIURL("image"))
+}
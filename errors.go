@@ -0,0 +1,144 @@
+package imgur
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Sentinel errors, use errors.Is to check if an error is one of them
+var (
+	// ErrNotFound means the requested image, album, etc. does not exist
+	ErrNotFound = errors.New("imgur resource not found")
+	// ErrUnauthorized means the credentials are invalid or lack the permissions for a request
+	ErrUnauthorized = errors.New("imgur request unauthorized")
+	// ErrRateLimited means the credits are exhausted, either reported by imgur or
+	// detected by the client before sending the request
+	ErrRateLimited = errors.New("imgur rate limit exhausted")
+	// ErrCircuitOpen means the request was not sent, as the circuit breaker of the
+	// client is open after repeated failures of imgur, see WithCircuitBreaker
+	ErrCircuitOpen = errors.New("imgur circuit breaker open")
+	// ErrServiceUnavailable means imgur failed with a 5xx status or answered with a page
+	// that is not JSON, e.g. the HTML page it shows when it is over capacity
+	ErrServiceUnavailable = errors.New("imgur service unavailable")
+	// ErrResponseTooLarge means the body of a response exceeded the limit set with WithMaxResponseSize
+	ErrResponseTooLarge = errors.New("imgur response too large")
+	// ErrUploadTooLarge means the upload was not sent, as it exceeds the size imgur accepts
+	ErrUploadTooLarge = errors.New("upload too large for imgur")
+	// ErrUnsupportedFormat means the upload was not sent, as imgur does not accept its format
+	ErrUnsupportedFormat = errors.New("upload format not supported by imgur")
+)
+
+// APIError is returned if imgur answered a request with an error
+type APIError struct {
+	StatusCode int    // HTTP status code, or the status of the imgur response if the HTTP request succeeded
+	Message    string // The error message imgur sent in data.error, if any
+	Method     string // HTTP method of the failed request
+	URL        string // URL of the failed request
+	Body       string // Start of the response if it was not JSON, e.g. an HTML error page
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("imgur request %v %v failed with status %v", e.Method, e.URL, e.StatusCode)
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+// Is makes errors.Is match an APIError against ErrNotFound, ErrUnauthorized, ErrRateLimited
+// and ErrServiceUnavailable
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == 404
+	case ErrUnauthorized:
+		return e.StatusCode == 401 || e.StatusCode == 403
+	case ErrRateLimited:
+		return e.StatusCode == 429
+	case ErrServiceUnavailable:
+		return e.StatusCode >= 500 || (e.Body != "" && e.StatusCode < 400)
+	}
+	return false
+}
+
+// errorDataWrapper is the envelope imgur sends for failed requests
+type errorDataWrapper struct {
+	Data struct {
+		Error json.RawMessage `json:"error"`
+	} `json:"data"`
+	Status  int    `json:"status"`
+	Message string `json:"message"` // RapidAPI sends its errors outside of data
+}
+
+// maxErrorPageBody is the number of bytes of a page that is not JSON kept in APIError.Body
+const maxErrorPageBody = 256
+
+// NewAPIError creates an APIError for a failed request. The message is taken from
+// body, the raw response of imgur, if possible. If body is not JSON, the start of it
+// is kept in Body and the title of an HTML page is used as message.
+func NewAPIError(method string, URL string, status int, body []byte) *APIError {
+	e := &APIError{
+		StatusCode: status,
+		Method:     method,
+		URL:        URL,
+	}
+	if isErrorPage(body) {
+		e.Body = truncateUTF8(strings.TrimSpace(string(body)), maxErrorPageBody)
+		if m := htmlTitle.FindSubmatch(body); m != nil {
+			e.Message = strings.Join(strings.Fields(string(m[1])), " ")
+		}
+		return e
+	}
+
+	var wrapper errorDataWrapper
+	if err := json.Unmarshal(body, &wrapper); err != nil {
+		return e
+	}
+	if len(wrapper.Data.Error) == 0 {
+		e.Message = wrapper.Message
+		return e
+	}
+	e.Message = errorMessage(wrapper.Data.Error)
+	return e
+}
+
+// errorMessage returns the message of the data.error field of a response
+func errorMessage(raw json.RawMessage) string {
+	// data.error is usually a string, but some endpoints send an object with a message
+	var msg string
+	if err := json.Unmarshal(raw, &msg); err == nil {
+		return msg
+	}
+	var obj struct {
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(raw, &obj); err == nil {
+		return obj.Message
+	}
+	return strings.TrimSpace(string(raw))
+}
+
+// isErrorPage reports if body is not a JSON response of the API, but e.g. the
+// HTML page of a proxy. Empty bodies are not considered pages.
+func isErrorPage(body []byte) bool {
+	body = bytes.TrimSpace(body)
+	return len(body) > 0 && body[0] != '{' && body[0] != '['
+}
+
+var htmlTitle = regexp.MustCompile(`(?is)<title[^>]*>([^<]*)</title>`)
+
+// truncateUTF8 cuts s to at most n bytes without splitting a character
+func truncateUTF8(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
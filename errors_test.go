@@ -0,0 +1,137 @@
+package imgur
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testHTTPClientError(status int, body string) (*http.Client, func()) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		fmt.Fprint(w, body)
+	})
+	return httpC, server.Close
+}
+
+func TestAPIErrorFromResponse(t *testing.T) {
+	httpC, closeServer := testHTTPClientError(404, `{"data":{"error":"Unable to find an image with the id, asd","request":"\/3\/image\/asd","method":"GET"},"success":false,"status":404}`)
+	defer closeServer()
+
+	client, _ := NewClient(httpC, "testing", "")
+	_, _, err := client.GetImageInfo("asd")
+	require.ErrorIs(t, err, ErrNotFound)
+	require.NotErrorIs(t, err, ErrUnauthorized)
+
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, 404, apiErr.StatusCode)
+	require.Equal(t, "GET", apiErr.Method)
+	require.Equal(t, "https://api.imgur.com/3/image/asd", apiErr.URL)
+	require.Equal(t, "Unable to find an image with the id, asd", apiErr.Message)
+}
+
+func TestAPIErrorFromUpload(t *testing.T) {
+	httpC, closeServer := testHTTPClientError(429, `{"data":{"error":{"code":429,"message":"You are uploading too fast. Please wait -0 more minutes.","type":"ImgurException"},"request":"\/3\/image","method":"POST"},"success":false,"status":429}`)
+	defer closeServer()
+
+	client, _ := NewClient(httpC, "testing", "", WithRetryPolicy(testRetryPolicy))
+	_, status, err := client.Upload(context.Background(), BytesSource([]byte("image")))
+	require.Equal(t, 429, status)
+	require.ErrorIs(t, err, ErrRateLimited)
+
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, "POST", apiErr.Method)
+	require.Equal(t, "You are uploading too fast. Please wait -0 more minutes.", apiErr.Message)
+}
+
+func TestAPIErrorUnsuccessfulEnvelope(t *testing.T) {
+	httpC, server := testHTTPClientJSON(`{"data":{"error":"Authentication required"},"success":false,"status":401}`)
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	_, status, err := client.GetAlbumInfo("asd")
+	require.Equal(t, 401, status)
+	require.ErrorIs(t, err, ErrUnauthorized)
+}
+
+func TestAPIErrorIs(t *testing.T) {
+	tests := []struct {
+		status int
+		target error
+	}{
+		{404, ErrNotFound},
+		{401, ErrUnauthorized},
+		{403, ErrUnauthorized},
+		{429, ErrRateLimited},
+	}
+	for _, test := range tests {
+		err := fmt.Errorf("wrapped - %w", &APIError{StatusCode: test.status})
+		require.ErrorIs(t, err, test.target)
+	}
+
+	require.NotErrorIs(t, &APIError{StatusCode: 500}, ErrNotFound)
+	require.NotErrorIs(t, &APIError{StatusCode: 500}, ErrRateLimited)
+}
+
+func TestAPIErrorMessage(t *testing.T) {
+	err := NewAPIError("GET", "https://api.imgur.com/3/image/asd", 500, []byte("<html>over capacity</html>"))
+	require.Equal(t, "imgur request GET https://api.imgur.com/3/image/asd failed with status 500", err.Error())
+
+	err = NewAPIError("GET", "https://api.imgur.com/3/image/asd", 400, []byte(`{"data":{"error":"Bad request"}}`))
+	require.Equal(t, "imgur request GET https://api.imgur.com/3/image/asd failed with status 400: Bad request", err.Error())
+
+	err = NewAPIError("GET", "https://imgur-apiv3.p.rapidapi.com/3/credits", 403, []byte(`{"message":"You are not subscribed to this API."}`))
+	require.Equal(t, "You are not subscribed to this API.", err.Message)
+}
+
+func TestAPIErrorFromErrorPage(t *testing.T) {
+	page := "<!DOCTYPE html>\n<html><head><title>Imgur is\n over capacity!</title></head><body>" + strings.Repeat("<p>Please try again</p>", 100) + "</body></html>"
+	var requests int
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, page)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithRetryPolicy(RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}))
+	_, _, err := client.GetImageInfo("asd")
+	require.ErrorIs(t, err, ErrServiceUnavailable)
+	require.NotContains(t, err.Error(), "Please try again")
+	require.Equal(t, 2, requests)
+
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, 200, apiErr.StatusCode)
+	require.Equal(t, "Imgur is over capacity!", apiErr.Message)
+	require.Len(t, apiErr.Body, maxErrorPageBody)
+	require.True(t, strings.HasPrefix(page, apiErr.Body))
+
+	// the upload might have been stored, it is not retried
+	requests = 0
+	_, status, err := client.Upload(context.Background(), BytesSource([]byte("image")))
+	require.ErrorIs(t, err, ErrServiceUnavailable)
+	require.Equal(t, 200, status)
+	require.Equal(t, 1, requests)
+	require.NotContains(t, err.Error(), "Please try again")
+	_, _, err = client.GetCommentReplies(context.Background(), 1)
+	require.ErrorIs(t, err, ErrServiceUnavailable)
+}
+
+func TestAPIErrorServiceUnavailable(t *testing.T) {
+	require.ErrorIs(t, &APIError{StatusCode: 503}, ErrServiceUnavailable)
+	require.ErrorIs(t, &APIError{StatusCode: 200, Body: "<html>"}, ErrServiceUnavailable)
+	require.NotErrorIs(t, &APIError{StatusCode: 404, Body: "<html>"}, ErrServiceUnavailable)
+	require.NotErrorIs(t, &APIError{StatusCode: 429}, ErrServiceUnavailable)
+	require.Equal(t, "a", truncateUTF8("aéb", 2))
+	require.Equal(t, "aé", truncateUTF8("aéb", 3))
+}
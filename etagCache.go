@@ -0,0 +1,147 @@
+package imgur
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// CachedResponse is a response of imgur stored with its ETag for conditional requests
+type CachedResponse struct {
+	ETag   string      // The ETag header of the response
+	Header http.Header // Headers of the response
+	Body   []byte      // Body of the response
+}
+
+// ETagCache stores responses for conditional requests, see WithETagCache.
+// Implementations have to be safe for concurrent use, e.g. to share a cache
+// between processes with a database.
+type ETagCache interface {
+	// Get returns the response stored for key, false if there is none
+	Get(key string) (*CachedResponse, bool)
+	// Set stores res for key, replacing a response stored before
+	Set(key string, res *CachedResponse)
+}
+
+// WithETagCache stores the successful responses of GET requests to the API that carry an
+// ETag in cache. Further requests for them are sent with If-None-Match and if imgur answers
+// with 304 Not Modified the stored response is returned, with the headers of the 304 response.
+// The keys of the cache are derived from the URL and the credentials of a request,
+// they do not contain the credentials.
+func WithETagCache(cache ETagCache) ClientOption {
+	return func(c *Client) {
+		c.etagCache = cache
+	}
+}
+
+// etagTransport sends conditional requests for the responses in cache
+type etagTransport struct {
+	next    http.RoundTripper
+	cache   ETagCache
+	prefix  string // only URLs of the API starting with prefix are cached
+	maxSize int64  // limit for the bodies of stored responses
+}
+
+func (t *etagTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || req.Header.Get("Range") != "" || !strings.HasPrefix(req.URL.String(), t.prefix) {
+		return t.next.RoundTrip(req)
+	}
+
+	key := cacheKey(req)
+	cached, ok := t.cache.Get(key)
+	if ok && cached.ETag != "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", cached.ETag)
+	}
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode == http.StatusNotModified && ok {
+		discardBody(res)
+		header := cached.Header.Clone()
+		if header == nil {
+			header = http.Header{}
+		}
+		// e.g. the rate limits of the 304 response are current
+		for k, v := range res.Header {
+			header[k] = v
+		}
+		res.Status = "200 OK"
+		res.StatusCode = http.StatusOK
+		res.Header = header
+		res.Body = ioutil.NopCloser(bytes.NewReader(cached.Body))
+		res.ContentLength = int64(len(cached.Body))
+		return res, nil
+	}
+
+	etag := res.Header.Get("ETag")
+	if res.StatusCode != http.StatusOK || etag == "" {
+		return res, nil
+	}
+	body, err := ioutil.ReadAll(limitBody(res.Body, t.maxSize))
+	res.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("Problem reading the body for %v - %w", req.URL, err)
+	}
+	t.cache.Set(key, &CachedResponse{ETag: etag, Header: res.Header.Clone(), Body: body})
+	res.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return res, nil
+}
+
+// cacheKey returns the cache key of req. Responses depend on the user, e.g. for
+// private albums, so the key contains a hash of the credentials. It is used by the
+// ETag cache and the response cache.
+func cacheKey(req *http.Request) string {
+	return cacheKeyFor(req.Header.Get("Authorization"), req.URL.String())
+}
+
+// cacheKeyFor returns the cache key of a request of URL sent with the Authorization header auth
+func cacheKeyFor(auth string, URL string) string {
+	sum := sha256.Sum256([]byte(auth))
+	return hex.EncodeToString(sum[:8]) + " " + URL
+}
+
+// MemoryETagCache is an ETagCache keeping the most recently used responses in memory
+type MemoryETagCache struct {
+	mu      sync.Mutex
+	entries *lru // of *CachedResponse
+}
+
+// NewMemoryETagCache creates a MemoryETagCache keeping up to maxEntries responses,
+// maxEntries below 1 means no limit.
+func NewMemoryETagCache(maxEntries int) *MemoryETagCache {
+	return &MemoryETagCache{entries: newLRU(maxEntries)}
+}
+
+// Get returns the response stored for key
+func (c *MemoryETagCache) Get(key string) (*CachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	res, ok := c.entries.get(key)
+	if !ok {
+		return nil, false
+	}
+	return res.(*CachedResponse), true
+}
+
+// Set stores res for key and evicts the least recently used response if the cache is full
+func (c *MemoryETagCache) Set(key string, res *CachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries.set(key, res)
+}
+
+// Len returns the number of stored responses
+func (c *MemoryETagCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.entries.len()
+}
@@ -0,0 +1,78 @@
+package imgur
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestETagCache(t *testing.T) {
+	var requests, notModified int
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("X-RateLimit-UserLimit", "500")
+		w.Header().Set("X-RateLimit-UserRemaining", fmt.Sprint(500-requests))
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `{"data":{"id":"ClF8rLe","title":"cached"},"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	cache := NewMemoryETagCache(10)
+	client, _ := NewClient(httpC, "testing", "", WithETagCache(cache))
+	for i := 0; i < 3; i++ {
+		img, status, err := client.GetImageInfo("ClF8rLe")
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, status)
+		require.Equal(t, "cached", img.Title)
+	}
+	require.Equal(t, 3, requests)
+	require.Equal(t, 2, notModified)
+	require.Equal(t, 1, cache.Len())
+	// the rate limits of the 304 responses are used
+	require.Equal(t, int64(497), client.LastRateLimit().UserRemaining)
+
+	// other credentials do not use the cached response
+	other, _ := NewClient(httpC, "testing", "", WithETagCache(cache), WithAccessToken("token"))
+	_, _, err := other.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+	require.Equal(t, 2, notModified)
+	require.Equal(t, 2, cache.Len())
+}
+
+func TestETagCacheNotModifiedWithoutEntry(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotModified)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithETagCache(NewMemoryETagCache(10)))
+	// a 304 is an error if the cache has no response for it
+	_, _, err := client.GetImageInfo("ClF8rLe")
+	require.Error(t, err)
+}
+
+func TestMemoryETagCacheEviction(t *testing.T) {
+	cache := NewMemoryETagCache(2)
+	cache.Set("a", &CachedResponse{ETag: "1"})
+	cache.Set("b", &CachedResponse{ETag: "2"})
+	_, ok := cache.Get("a")
+	require.True(t, ok)
+	cache.Set("c", &CachedResponse{ETag: "3"})
+
+	_, ok = cache.Get("b")
+	require.False(t, ok)
+	res, ok := cache.Get("a")
+	require.True(t, ok)
+	require.Equal(t, "1", res.ETag)
+	cache.Set("a", &CachedResponse{ETag: "4"})
+	res, _ = cache.Get("a")
+	require.Equal(t, "4", res.ETag)
+	require.Equal(t, 2, cache.Len())
+}
@@ -0,0 +1,268 @@
+package imgur
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strconv"
+	"time"
+)
+
+// ExportFormat is the format of the metadata in an account export
+type ExportFormat string
+
+// Formats of an account export
+const (
+	ExportJSON ExportFormat = "json" // A JSON array per listing with everything imgur sent
+	ExportCSV  ExportFormat = "csv"  // A CSV table per listing with the main fields
+)
+
+// ExportOptions selects what ExportAccount archives
+type ExportOptions struct {
+	Username  string       // The exported account, defaults to Me
+	Format    ExportFormat // The format of the metadata, defaults to ExportJSON
+	Images    bool         // Export the images uploaded by the user
+	Albums    bool         // Export the albums of the user
+	Comments  bool         // Export the comments the user made
+	Favorites bool         // Export the favorites of the user, requires an authenticated client
+	Files     bool         // Add the files of the exported images to the archive
+}
+
+// exportList is a listing of an account export
+type exportList struct {
+	name    string                                                     // Name of the archive entry without extension
+	columns []string                                                   // Header of the CSV table
+	fetch   func(ctx context.Context, page int) ([]interface{}, error) // Items of a page, none after the last page
+	row     func(item interface{}) []string                            // CSV record of an item
+}
+
+// ExportAccount writes a zip archive with the metadata of an account to w, e.g. for a backup.
+// Every selected listing is paginated to the end and stored as images, albums, comments or
+// favorites with the extension of the format. With Files, the image files are stored in
+// the files directory of the archive, named after the image IDs.
+// returns error
+func (client *Client) ExportAccount(ctx context.Context, w io.Writer, opts ExportOptions) error {
+	username := opts.Username
+	if username == "" {
+		username = Me
+	}
+	format := opts.Format
+	if format == "" {
+		format = ExportJSON
+	}
+	if format != ExportJSON && format != ExportCSV {
+		return fmt.Errorf("Unknown export format %v", format)
+	}
+
+	var images []*ImageInfo
+	var lists []exportList
+	if opts.Images {
+		lists = append(lists, exportList{
+			name:    "images",
+			columns: []string{"id", "title", "description", "datetime", "type", "width", "height", "size", "views", "link", "deletehash", "name"},
+			fetch: func(ctx context.Context, page int) ([]interface{}, error) {
+				imgs, _, err := client.GetAccountImages(ctx, username, page)
+				items := make([]interface{}, len(imgs))
+				for i := range imgs {
+					items[i] = &imgs[i]
+					if opts.Files {
+						images = append(images, &imgs[i])
+					}
+				}
+				return items, err
+			},
+			row: func(item interface{}) []string {
+				img := item.(*ImageInfo)
+				return []string{string(img.ID), img.Title, img.Description, exportTime(img.Datetime), img.MimeType,
+					strconv.Itoa(img.Width), strconv.Itoa(img.Height), strconv.Itoa(img.Size), strconv.Itoa(img.Views),
+					img.Link, string(img.Deletehash), img.Name}
+			},
+		})
+	}
+	if opts.Albums {
+		lists = append(lists, exportList{
+			name:    "albums",
+			columns: []string{"id", "title", "description", "datetime", "privacy", "images_count", "views", "link", "deletehash"},
+			fetch: func(ctx context.Context, page int) ([]interface{}, error) {
+				albums, _, err := client.GetAccountAlbums(ctx, username, page)
+				items := make([]interface{}, len(albums))
+				for i := range albums {
+					items[i] = &albums[i]
+				}
+				return items, err
+			},
+			row: func(item interface{}) []string {
+				a := item.(*AlbumInfo)
+				return []string{string(a.ID), a.Title, a.Description, exportTime(a.DateTime), a.Privacy,
+					strconv.Itoa(a.ImagesCount), strconv.Itoa(a.Views), a.Link, string(a.Deletehash)}
+			},
+		})
+	}
+	if opts.Comments {
+		lists = append(lists, exportList{
+			name:    "comments",
+			columns: []string{"id", "post", "on_album", "parent_id", "comment", "datetime", "points"},
+			fetch: func(ctx context.Context, page int) ([]interface{}, error) {
+				comments, _, err := client.GetAccountComments(ctx, username, CommentsNewest, page)
+				items := make([]interface{}, len(comments))
+				for i := range comments {
+					items[i] = &comments[i]
+				}
+				return items, err
+			},
+			row: func(item interface{}) []string {
+				c := item.(*Comment)
+				parent := ""
+				if c.ParentID != 0 {
+					parent = c.ParentID.String()
+				}
+				return []string{c.ID.String(), c.ImageID, strconv.FormatBool(c.OnAlbum), parent, c.Comment,
+					exportTime(c.Datetime), strconv.FormatFloat(float64(c.Points), 'f', -1, 32)}
+			},
+		})
+	}
+	if opts.Favorites {
+		lists = append(lists, exportList{
+			name:    "favorites",
+			columns: []string{"id", "is_album", "title", "datetime", "account_url", "link"},
+			fetch: func(ctx context.Context, page int) ([]interface{}, error) {
+				favorites, _, err := client.GetAccountFavorites(ctx, username, page, FavoritesNewest)
+				items := make([]interface{}, len(favorites))
+				for i := range favorites {
+					items[i] = &favorites[i]
+				}
+				return items, err
+			},
+			row: func(item interface{}) []string {
+				fav := item.(*GalleryItem)
+				if fav.IsAlbum() {
+					a := fav.AsAlbum()
+					return []string{string(a.ID), "true", a.Title, exportTime(a.DateTime), a.AccountURL, a.Link}
+				}
+				img := fav.AsImage()
+				return []string{string(img.ID), "false", img.Title, exportTime(img.Datetime), img.AccountURL, img.Link}
+			},
+		})
+	}
+	if len(lists) == 0 {
+		return fmt.Errorf("Nothing selected to export")
+	}
+
+	zw := zip.NewWriter(w)
+	for _, list := range lists {
+		if err := writeExportList(ctx, zw, format, list); err != nil {
+			return err
+		}
+	}
+	for _, img := range images {
+		if err := client.exportFile(ctx, zw, img); err != nil {
+			return err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("Problem writing export archive - %w", err)
+	}
+	return nil
+}
+
+// writeExportList stores all pages of list in an entry of zw
+func writeExportList(ctx context.Context, zw *zip.Writer, format ExportFormat, list exportList) error {
+	name := list.name + "." + string(format)
+	f, err := zw.Create(name)
+	if err != nil {
+		return fmt.Errorf("Problem writing %v to export archive - %w", name, err)
+	}
+
+	var write func(item interface{}) error
+	var finish func() error
+	if format == ExportCSV {
+		cw := csv.NewWriter(f)
+		if err := cw.Write(list.columns); err != nil {
+			return fmt.Errorf("Problem writing %v to export archive - %w", name, err)
+		}
+		write = func(item interface{}) error {
+			return cw.Write(list.row(item))
+		}
+		finish = func() error {
+			cw.Flush()
+			return cw.Error()
+		}
+	} else {
+		n := 0
+		if _, err := io.WriteString(f, "["); err != nil {
+			return fmt.Errorf("Problem writing %v to export archive - %w", name, err)
+		}
+		write = func(item interface{}) error {
+			data, err := json.Marshal(item)
+			if err != nil {
+				return err
+			}
+			sep := ",\n"
+			if n == 0 {
+				sep = "\n"
+			}
+			n++
+			_, err = io.WriteString(f, sep+string(data))
+			return err
+		}
+		finish = func() error {
+			_, err := io.WriteString(f, "\n]\n")
+			return err
+		}
+	}
+
+	for page := 0; ; page++ {
+		items, err := list.fetch(ctx, page)
+		if err != nil {
+			return fmt.Errorf("Problem exporting %v - %w", list.name, err)
+		}
+		if len(items) == 0 {
+			break
+		}
+		for _, item := range items {
+			if err := write(item); err != nil {
+				return fmt.Errorf("Problem writing %v to export archive - %w", name, err)
+			}
+		}
+	}
+	if err := finish(); err != nil {
+		return fmt.Errorf("Problem writing %v to export archive - %w", name, err)
+	}
+	return nil
+}
+
+// exportFile stores the file of img in the files directory of zw
+func (client *Client) exportFile(ctx context.Context, zw *zip.Writer, img *ImageInfo) error {
+	ext := ""
+	if u, err := url.Parse(img.Link); err == nil {
+		ext = path.Ext(u.Path)
+	}
+	res, err := client.openFile(ctx, img.Link, 0)
+	if err != nil {
+		return fmt.Errorf("Problem exporting file of image %v - %w", img.ID, err)
+	}
+	defer res.Body.Close()
+
+	name := "files/" + string(img.ID) + ext
+	f, err := zw.Create(name)
+	if err != nil {
+		return fmt.Errorf("Problem writing %v to export archive - %w", name, err)
+	}
+	if _, err := io.Copy(f, res.Body); err != nil {
+		return fmt.Errorf("Problem exporting file of image %v - %w", img.ID, err)
+	}
+	return nil
+}
+
+// exportTime formats t for CSV tables, empty if t is not set
+func exportTime(t UnixTime) string {
+	if t == 0 {
+		return ""
+	}
+	return t.Time().UTC().Format(time.RFC3339)
+}
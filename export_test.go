@@ -0,0 +1,126 @@
+package imgur
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testExportServer serves one page of every account listing and the file of the image
+func testExportServer(t *testing.T) (*http.Client, func()) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		if r.Host == "i.imgur.com" {
+			require.Equal(t, "/ClF8rLe.png", r.URL.Path)
+			fmt.Fprint(w, "png data")
+			return
+		}
+		require.Equal(t, "Bearer access", r.Header.Get("Authorization"))
+		data := "[]"
+		switch r.URL.Path {
+		case "/3/account/me/images/0":
+			data = `[{"id":"ClF8rLe","title":"Cat","datetime":1600000000,"type":"image/png","link":"https://i.imgur.com/ClF8rLe.png","deletehash":"hash"}]`
+		case "/3/account/me/albums/0":
+			data = `[{"id":"VZQXk","title":"Cats, more cats","images_count":2,"privacy":"hidden"}]`
+		case "/3/account/me/comments/newest/0":
+			data = `[{"id":1834,"image_id":"ClF8rLe","comment":"Nice \"cat\"","points":2,"parent_id":17}]`
+		case "/3/account/me/favorites/0/newest":
+			data = `[{"id":"VZQXk","is_album":true,"title":"Cats"},{"id":"ClF8rLe","is_album":false,"title":"Cat"}]`
+		case "/3/account/me/images/1", "/3/account/me/albums/1", "/3/account/me/comments/newest/1", "/3/account/me/favorites/1/newest":
+		default:
+			t.Errorf("Unexpected request %v", r.URL.Path)
+		}
+		fmt.Fprintf(w, `{"data":%v,"success":true,"status":200}`, data)
+	})
+	return httpC, server.Close
+}
+
+// readExport returns the entries of the zip archive in data
+func readExport(t *testing.T, data []byte) map[string]string {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	entries := map[string]string{}
+	for _, f := range zr.File {
+		r, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(r)
+		require.NoError(t, err)
+		entries[f.Name] = string(content)
+	}
+	return entries
+}
+
+func TestExportAccountJSON(t *testing.T) {
+	httpC, closeServer := testExportServer(t)
+	defer closeServer()
+
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	var buf bytes.Buffer
+	err := client.ExportAccount(context.Background(), &buf, ExportOptions{Images: true, Albums: true, Comments: true, Favorites: true, Files: true})
+	require.NoError(t, err)
+
+	entries := readExport(t, buf.Bytes())
+	require.Len(t, entries, 5)
+	require.Equal(t, "png data", entries["files/ClF8rLe.png"])
+
+	var images []ImageInfo
+	require.NoError(t, json.Unmarshal([]byte(entries["images.json"]), &images))
+	require.Len(t, images, 1)
+	require.Equal(t, DeleteHash("hash"), images[0].Deletehash)
+
+	var favorites []GalleryItem
+	require.NoError(t, json.Unmarshal([]byte(entries["favorites.json"]), &favorites))
+	require.Len(t, favorites, 2)
+	require.True(t, favorites[0].IsAlbum())
+
+	var comments []Comment
+	require.NoError(t, json.Unmarshal([]byte(entries["comments.json"]), &comments))
+	require.Equal(t, CommentID(1834), comments[0].ID)
+	require.Contains(t, entries, "albums.json")
+}
+
+func TestExportAccountCSV(t *testing.T) {
+	httpC, closeServer := testExportServer(t)
+	defer closeServer()
+
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	var buf bytes.Buffer
+	err := client.ExportAccount(context.Background(), &buf, ExportOptions{Format: ExportCSV, Images: true, Albums: true, Comments: true})
+	require.NoError(t, err)
+
+	entries := readExport(t, buf.Bytes())
+	require.Len(t, entries, 3)
+
+	records, err := csv.NewReader(bytes.NewReader([]byte(entries["images.csv"]))).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		{"id", "title", "description", "datetime", "type", "width", "height", "size", "views", "link", "deletehash", "name"},
+		{"ClF8rLe", "Cat", "", "2020-09-13T12:26:40Z", "image/png", "0", "0", "0", "0", "https://i.imgur.com/ClF8rLe.png", "hash", ""},
+	}, records)
+
+	records, err = csv.NewReader(bytes.NewReader([]byte(entries["albums.csv"]))).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, "Cats, more cats", records[1][1])
+
+	records, err = csv.NewReader(bytes.NewReader([]byte(entries["comments.csv"]))).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, []string{"1834", "ClF8rLe", "false", "17", `Nice "cat"`, "", "2"}, records[1])
+}
+
+func TestExportAccountErrors(t *testing.T) {
+	client, _ := NewClient(new(http.Client), "testing", "")
+	var buf bytes.Buffer
+	require.Error(t, client.ExportAccount(context.Background(), &buf, ExportOptions{}))
+	require.Error(t, client.ExportAccount(context.Background(), &buf, ExportOptions{Images: true, Format: "xml"}))
+
+	// anonymous clients can not export Me
+	err := client.ExportAccount(context.Background(), &buf, ExportOptions{Images: true})
+	require.ErrorIs(t, err, ErrUnauthorized)
+}
@@ -0,0 +1,36 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+)
+
+// FavoriteImage toggles whether the image is a favorite of the user. Requires an authenticated client.
+// returns true if the image is a favorite now, status code of the request, error
+func (client *Client) FavoriteImage(ctx context.Context, imageID ImageID) (bool, int, error) {
+	return client.favorite(ctx, "image", imageID)
+}
+
+// FavoriteAlbum toggles whether the album is a favorite of the user. Requires an authenticated client.
+// returns true if the album is a favorite now, status code of the request, error
+func (client *Client) FavoriteAlbum(ctx context.Context, albumID AlbumID) (bool, int, error) {
+	return client.favorite(ctx, "album", albumID)
+}
+
+func (client *Client) favorite(ctx context.Context, kind string, ref fmt.Stringer) (bool, int, error) {
+	id := refString(ref)
+	if id == "" {
+		return false, -1, fmt.Errorf("ID of the %v is empty", kind)
+	}
+	if !client.authenticated() {
+		return false, -1, fmt.Errorf("Favoriting %v %v requires an access token - %w", kind, id, ErrUnauthorized)
+	}
+
+	var state string
+	_, status, err := client.send(ctx, "POST", kind+"/"+id+"/favorite", nil, &state)
+	if err != nil {
+		return false, status, fmt.Errorf("Problem favoriting %v %v - %w", kind, id, err)
+	}
+	// imgur answers with "favorited" or "unfavorited"
+	return state == "favorited", status, nil
+}
@@ -0,0 +1,47 @@
+package imgur
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFavorite(t *testing.T) {
+	favorites := map[string]bool{}
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "POST", r.Method)
+		require.Equal(t, "Bearer access", r.Header.Get("Authorization"))
+		favorites[r.URL.Path] = !favorites[r.URL.Path]
+		state := "unfavorited"
+		if favorites[r.URL.Path] {
+			state = "favorited"
+		}
+		fmt.Fprint(w, `{"data":"`+state+`","success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	favorited, status, err := client.FavoriteImage(context.Background(), "ClF8rLe")
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.True(t, favorited)
+
+	favorited, _, err = client.FavoriteImage(context.Background(), "ClF8rLe")
+	require.NoError(t, err)
+	require.False(t, favorited)
+
+	favorited, _, err = client.FavoriteAlbum(context.Background(), "VZQXk")
+	require.NoError(t, err)
+	require.True(t, favorited)
+	require.True(t, favorites["/3/album/VZQXk/favorite"])
+}
+
+func TestFavoriteAnonymous(t *testing.T) {
+	client, _ := NewClient(new(http.Client), "testing", "")
+	_, _, err := client.FavoriteImage(context.Background(), "ClF8rLe")
+	require.True(t, errors.Is(err, ErrUnauthorized))
+}
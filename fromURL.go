@@ -1,6 +1,7 @@
 package imgur
 
 import (
+	"context"
 	"errors"
 	"strings"
 )
@@ -65,32 +66,37 @@ func extractIdFromUrl(url string) string {
 // GetInfoFromURL tries to query imgur based on information identified in the URL.
 // returns image/album info, status code of the request, error
 func (client *Client) GetInfoFromURL(url string) (*GenericInfo, int, error) {
+	return client.GetInfoFromURLWithContext(context.Background(), url)
+}
+
+// GetInfoFromURLWithContext is like GetInfoFromURL, but all requests are bound to ctx
+func (client *Client) GetInfoFromURLWithContext(ctx context.Context, url string) (*GenericInfo, int, error) {
 	url = strings.TrimSpace(url)
 
 	// https://i.imgur.com/<id>.jpg -> image
 	if matchesSlice(url, directURLPatterns) {
-		return client.directImageURL(url)
+		return client.directImageURL(ctx, url)
 	}
 
 	// https://imgur.com/a/<id> -> album
 	if matchesSlice(url, albumURLPatterns) {
-		return client.albumURL(url)
+		return client.albumURL(ctx, url)
 	}
 
 	// https://imgur.com/gallery/<id> -> gallery album
 	if matchesSlice(url, galleryURLPatterns) {
-		return client.galleryURL(url)
+		return client.galleryURL(ctx, url)
 	}
 
 	// https://imgur.com/<id> -> image
 	if matchesSlice(url, imageURLPatterns) {
-		return client.imageURL(url)
+		return client.imageURL(ctx, url)
 	}
 
 	return nil, -1, errors.New("URL pattern matching for URL " + url + " failed.")
 }
 
-func (client *Client) directImageURL(url string) (*GenericInfo, int, error) {
+func (client *Client) directImageURL(ctx context.Context, url string) (*GenericInfo, int, error) {
 	var ret GenericInfo
 	start := strings.LastIndex(url, "/") + 1
 	end := strings.LastIndex(url, ".")
@@ -99,18 +105,18 @@ func (client *Client) directImageURL(url string) (*GenericInfo, int, error) {
 	}
 	id := url[start:end]
 	client.Log.Debugf("Detected imgur image ID %v. Was going down the i.imgur.com/ path.", id)
-	gii, status, err := client.GetGalleryImageInfo(id)
+	gii, status, err := client.GetGalleryImageInfoWithContext(ctx, ImageID(id))
 	if err == nil && status < 400 {
 		ret.GImage = gii
 	} else {
 		var ii *ImageInfo
-		ii, status, err = client.GetImageInfo(id)
+		ii, status, err = client.GetImageInfoWithContext(ctx, ImageID(id))
 		ret.Image = ii
 	}
 	return &ret, status, err
 }
 
-func (client *Client) albumURL(url string) (*GenericInfo, int, error) {
+func (client *Client) albumURL(ctx context.Context, url string) (*GenericInfo, int, error) {
 	var ret GenericInfo
 
 	id := extractIdFromUrl(url)
@@ -118,12 +124,12 @@ func (client *Client) albumURL(url string) (*GenericInfo, int, error) {
 		return nil, -1, errors.New("Could not find ID in URL " + url + ". I was going down imgur.com/a/ path.")
 	}
 	client.Log.Debugf("Detected imgur album ID %v. Was going down the imgur.com/a/ path.", id)
-	ai, status, err := client.GetAlbumInfo(id)
+	ai, status, err := client.GetAlbumInfoWithContext(ctx, AlbumID(id))
 	ret.Album = ai
 	return &ret, status, err
 }
 
-func (client *Client) galleryURL(url string) (*GenericInfo, int, error) {
+func (client *Client) galleryURL(ctx context.Context, url string) (*GenericInfo, int, error) {
 	var ret GenericInfo
 
 	id := extractIdFromUrl(url)
@@ -131,19 +137,19 @@ func (client *Client) galleryURL(url string) (*GenericInfo, int, error) {
 		return nil, -1, errors.New("Could not find ID in URL " + url + ". I was going down imgur.com/gallery/ path.")
 	}
 	client.Log.Debugf("Detected imgur gallery ID %v. Was going down the imgur.com/gallery/ path.", id)
-	ai, status, err := client.GetGalleryAlbumInfo(id)
+	ai, status, err := client.GetGalleryAlbumInfoWithContext(ctx, AlbumID(id))
 	if err == nil && status < 400 {
 		ret.GAlbum = ai
 		return &ret, status, err
 	}
 	// fallback to GetGalleryImageInfo
 	client.Log.Debugf("Failed to retrieve imgur gallery album. Attempting to retrieve imgur gallery image. err: %v status: %d", err, status)
-	ii, status, err := client.GetGalleryImageInfo(id)
+	ii, status, err := client.GetGalleryImageInfoWithContext(ctx, ImageID(id))
 	ret.GImage = ii
 	return &ret, status, err
 }
 
-func (client *Client) imageURL(url string) (*GenericInfo, int, error) {
+func (client *Client) imageURL(ctx context.Context, url string) (*GenericInfo, int, error) {
 	var ret GenericInfo
 
 	id := extractIdFromUrl(url)
@@ -151,14 +157,14 @@ func (client *Client) imageURL(url string) (*GenericInfo, int, error) {
 		return nil, -1, errors.New("Could not find ID in URL " + url + ". I was going down imgur.com/ path.")
 	}
 	client.Log.Debugf("Detected imgur image ID %v. Was going down the imgur.com/ path.", id)
-	ii, status, err := client.GetGalleryImageInfo(id)
+	ii, status, err := client.GetGalleryImageInfoWithContext(ctx, ImageID(id))
 	if err == nil && status < 400 {
 		ret.GImage = ii
 
 		return &ret, status, err
 	}
 
-	i, st, err := client.GetImageInfo(id)
+	i, st, err := client.GetImageInfoWithContext(ctx, ImageID(id))
 	ret.Image = i
 	return &ret, st, err
 }
@@ -0,0 +1,93 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// GallerySection is a part of the imgur gallery
+type GallerySection string
+
+// Sections of the gallery
+const (
+	SectionHot  GallerySection = "hot"  // The most viral posts
+	SectionTop  GallerySection = "top"  // The highest scoring posts
+	SectionUser GallerySection = "user" // Posts submitted by users
+)
+
+// GetGallery lists a page of the gallery, as shown on the imgur front page.
+// Empty section, sort or window default to SectionHot, SortViral and WindowDay.
+// showViral includes viral posts in the user section, mature includes posts marked as mature.
+// returns the images and albums of the page, status code of the request, error
+func (client *Client) GetGallery(ctx context.Context, section GallerySection, sort GallerySort, window GalleryWindow, page int, showViral bool, mature bool) ([]GalleryItem, int, error) {
+	var items []GalleryItem
+	_, status, err := client.send(ctx, "GET", galleryPath(section, sort, window, page), galleryParams(showViral, mature), &items)
+	if err != nil {
+		return nil, status, fmt.Errorf("Problem getting gallery %v - %w", section, err)
+	}
+	return items, status, nil
+}
+
+// galleryPath returns the path of a page of the gallery, applying the defaults of GetGallery
+func galleryPath(section GallerySection, sort GallerySort, window GalleryWindow, page int) string {
+	if section == "" {
+		section = SectionHot
+	}
+	if sort == "" {
+		sort = SortViral
+	}
+	if window == "" {
+		window = WindowDay
+	}
+	return "gallery/" + string(section) + "/" + string(sort) + "/" + string(window) + "/" + strconv.Itoa(page)
+}
+
+func galleryParams(showViral bool, mature bool) url.Values {
+	return url.Values{
+		"showViral": {strconv.FormatBool(showViral)},
+		"mature":    {strconv.FormatBool(mature)},
+	}
+}
+
+// GalleryIterator iterates over the images and albums of a gallery listing, fetching pages
+// as needed. The posts are decoded one by one while the response is read, so even the
+// thousands of posts of SortTop and WindowAll are not held in memory at once.
+// Close releases the response if the iteration is stopped early.
+//
+//	it := client.Gallery(imgur.SectionTop, imgur.SortTop, imgur.WindowAll, false, false)
+//	defer it.Close()
+//	for it.Next(ctx) {
+//		fmt.Println(it.Item().AsImage().Title)
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type GalleryIterator struct {
+	pager
+	item *GalleryItem
+}
+
+// Next advances to the next post and reports whether there is one
+func (it *GalleryIterator) Next(ctx context.Context) bool {
+	it.item = &GalleryItem{}
+	return it.next(ctx, it.item)
+}
+
+// Item returns the current post
+func (it *GalleryIterator) Item() *GalleryItem {
+	return it.item
+}
+
+// Gallery returns an iterator over all posts of the gallery, the arguments are those of GetGallery
+func (client *Client) Gallery(section GallerySection, sort GallerySort, window GalleryWindow, showViral bool, mature bool) *GalleryIterator {
+	return &GalleryIterator{pager: pager{
+		client: client,
+		path: func(page int) (string, error) {
+			return galleryPath(section, sort, window, page), nil
+		},
+		params: galleryParams(showViral, mature),
+		what:   "gallery " + string(section),
+	}}
+}
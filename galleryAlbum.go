@@ -1,10 +1,11 @@
 package imgur
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
-	"strconv"
+	"fmt"
 	"strings"
+	"time"
 )
 
 type galleryAlbumInfoDataWrapper struct {
@@ -15,54 +16,67 @@ type galleryAlbumInfoDataWrapper struct {
 
 // GalleryAlbumInfo contains all information provided by imgur of a gallery album
 type GalleryAlbumInfo struct {
-	ID           string      `json:"id"`               // The ID for the album
-	Title        string      `json:"title"`            // The title of the album in the gallery
-	Description  string      `json:"description"`      // The description of the album in the gallery
-	DateTime     int         `json:"datetime"`         // Time inserted into the gallery, epoch time
-	Cover        string      `json:"cover"`            // The ID of the album cover image
-	CoverWidth   int         `json:"cover_width"`      // The width, in pixels, of the album cover image
-	CoverHeight  int         `json:"cover_height"`     // The height, in pixels, of the album cover image
-	AccountURL   string      `json:"account_url"`      // The account username or null if it's anonymous.
-	AccountID    int         `json:"account_id"`       // The account ID or null if it's anonymous.
-	Privacy      string      `json:"privacy"`          // The privacy level of the album, you can only view public if not logged in as album owner
-	Layout       string      `json:"layout"`           // The view layout of the album.
-	Views        int         `json:"views"`            // The number of album views
-	Link         string      `json:"link"`             // The URL link to the album
-	Ups          int         `json:"ups"`              // Upvotes for the image
-	Downs        int         `json:"downs"`            // Number of downvotes for the image
-	Points       int         `json:"points"`           // Upvotes minus downvotes
-	Score        int         `json:"score"`            // Imgur popularity score
-	IsAlbum      bool        `json:"is_album"`         // if it's an album or not
-	Vote         string      `json:"vote"`             // The current user's vote on the album. null if not signed in or if the user hasn't voted on it.
-	Favorite     bool        `json:"favorite"`         // Indicates if the current user favorited the image. Defaults to false if not signed in.
-	Nsfw         bool        `json:"nsfw"`             // Indicates if the image has been marked as nsfw or not. Defaults to null if information is not available.
-	CommentCount int         `json:"comment_count"`    // Number of comments on the gallery album.
-	Topic        string      `json:"topic"`            // Topic of the gallery album.
-	TopicID      int         `json:"topic_id"`         // Topic ID of the gallery album.
-	ImagesCount  int         `json:"images_count"`     // The total number of images in the album
-	Images       []ImageInfo `json:"images,omitempty"` // An array of all the images in the album (only available when requesting the direct album)
-	InMostViral  bool        `json:"in_most_viral"`    // Indicates if the album is in the most viral gallery or not.
-	Limit        *RateLimit  // Current rate limit
+	ID           AlbumID         `json:"id"`               // The ID for the album
+	Title        string          `json:"title"`            // The title of the album in the gallery
+	Description  string          `json:"description"`      // The description of the album in the gallery
+	DateTime     UnixTime        `json:"datetime"`         // Time inserted into the gallery, epoch time
+	Cover        ImageID         `json:"cover"`            // The ID of the album cover image
+	CoverWidth   int             `json:"cover_width"`      // The width, in pixels, of the album cover image
+	CoverHeight  int             `json:"cover_height"`     // The height, in pixels, of the album cover image
+	AccountURL   string          `json:"account_url"`      // The account username or null if it's anonymous.
+	AccountID    int             `json:"account_id"`       // The account ID or null if it's anonymous.
+	Privacy      string          `json:"privacy"`          // The privacy level of the album, you can only view public if not logged in as album owner
+	Layout       string          `json:"layout"`           // The view layout of the album.
+	Views        int             `json:"views"`            // The number of album views
+	Link         string          `json:"link"`             // The URL link to the album
+	Ups          int             `json:"ups"`              // Upvotes for the image
+	Downs        int             `json:"downs"`            // Number of downvotes for the image
+	Points       int             `json:"points"`           // Upvotes minus downvotes
+	Score        int             `json:"score"`            // Imgur popularity score
+	IsAlbum      bool            `json:"is_album"`         // if it's an album or not
+	Vote         string          `json:"vote"`             // The current user's vote on the album. null if not signed in or if the user hasn't voted on it.
+	Favorite     bool            `json:"favorite"`         // Indicates if the current user favorited the image. Defaults to false if not signed in.
+	Nsfw         bool            `json:"nsfw"`             // Indicates if the image has been marked as nsfw or not. Defaults to null if information is not available.
+	CommentCount int             `json:"comment_count"`    // Number of comments on the gallery album.
+	Topic        string          `json:"topic"`            // Topic of the gallery album.
+	TopicID      int             `json:"topic_id"`         // Topic ID of the gallery album.
+	ImagesCount  int             `json:"images_count"`     // The total number of images in the album
+	Images       []ImageInfo     `json:"images,omitempty"` // An array of all the images in the album (only available when requesting the direct album)
+	InMostViral  bool            `json:"in_most_viral"`    // Indicates if the album is in the most viral gallery or not.
+	Limit        *RateLimit      // Current rate limit
+	Raw          json.RawMessage `json:"-"` // The JSON sent by imgur, only kept with WithRawJSON
+}
+
+// CreatedAt returns when the album was inserted into the gallery
+func (ga *GalleryAlbumInfo) CreatedAt() time.Time {
+	return ga.DateTime.Time()
 }
 
 // GetGalleryAlbumInfo queries imgur for information on a gallery album
 // returns album info, status code of the request, error
-func (client *Client) GetGalleryAlbumInfo(id string) (*GalleryAlbumInfo, int, error) {
-	body, rl, err := client.getURL("gallery/album/" + id)
+func (client *Client) GetGalleryAlbumInfo(id AlbumID) (*GalleryAlbumInfo, int, error) {
+	return client.GetGalleryAlbumInfoWithContext(context.Background(), id)
+}
+
+// GetGalleryAlbumInfoWithContext is like GetGalleryAlbumInfo, but the request is bound to ctx
+func (client *Client) GetGalleryAlbumInfoWithContext(ctx context.Context, id AlbumID) (*GalleryAlbumInfo, int, error) {
+	path := "gallery/album/" + string(id)
+	body, rl, err := client.getURL(ctx, path)
 	if err != nil {
-		return nil, -1, errors.New("Problem getting URL for gallery album info ID " + id + " - " + err.Error())
+		return nil, -1, fmt.Errorf("Problem getting URL for gallery album info ID %v - %w", id, err)
 	}
 	// client.Log.Debugf("%v\n", body)
 
 	dec := json.NewDecoder(strings.NewReader(body))
 	var alb galleryAlbumInfoDataWrapper
 	if err := dec.Decode(&alb); err != nil {
-		return nil, -1, errors.New("Problem decoding json for gallery albumID " + id + " - " + err.Error())
+		return nil, -1, fmt.Errorf("Problem decoding json for gallery albumID %v - %w", id, err)
 	}
-	alb.Ai.Limit = rl
 
 	if !alb.Success {
-		return nil, alb.Status, errors.New("Request to imgur failed for gallery albumID " + id + " - " + strconv.Itoa(alb.Status))
+		return nil, alb.Status, fmt.Errorf("Request to imgur failed for gallery albumID %v - %w", id, NewAPIError("GET", client.createAPIURL(path), alb.Status, []byte(body)))
 	}
+	alb.Ai.Limit = rl
+	client.keepRawJSON([]byte(body), alb.Ai)
 	return alb.Ai, alb.Status, nil
 }
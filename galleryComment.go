@@ -0,0 +1,59 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+)
+
+// CommentSort is the order of comment listings
+type CommentSort string
+
+// Orders of comments on a gallery post
+const (
+	CommentsBest CommentSort = "best"
+	CommentsTop  CommentSort = "top"
+	CommentsNew  CommentSort = "new"
+)
+
+// Orders of the comments of an account, CommentsBest applies as well
+const (
+	CommentsWorst  CommentSort = "worst"
+	CommentsOldest CommentSort = "oldest"
+	CommentsNewest CommentSort = "newest"
+)
+
+// GetGalleryComments queries imgur for the comments on a gallery post, with the
+// replies of each comment in Children. An empty sort defaults to CommentsBest.
+// returns the top-level comments, status code of the request, error
+func (client *Client) GetGalleryComments(ctx context.Context, post PostRef, sort CommentSort) ([]Comment, int, error) {
+	postID := refString(post)
+	if postID == "" {
+		return nil, -1, fmt.Errorf("Gallery post ID is empty")
+	}
+	if sort == "" {
+		sort = CommentsBest
+	}
+
+	var comments []Comment
+	_, status, err := client.send(ctx, "GET", "gallery/"+postID+"/comments/"+string(sort), nil, &comments)
+	if err != nil {
+		return nil, status, fmt.Errorf("Problem getting comments of gallery post %v - %w", postID, err)
+	}
+	return comments, status, nil
+}
+
+// GetGalleryCommentCount queries imgur for the number of comments on a gallery post
+// returns the number of comments, status code of the request, error
+func (client *Client) GetGalleryCommentCount(ctx context.Context, post PostRef) (int, int, error) {
+	postID := refString(post)
+	if postID == "" {
+		return 0, -1, fmt.Errorf("Gallery post ID is empty")
+	}
+
+	var count int
+	_, status, err := client.send(ctx, "GET", "gallery/"+postID+"/comments/count", nil, &count)
+	if err != nil {
+		return 0, status, fmt.Errorf("Problem getting comment count of gallery post %v - %w", postID, err)
+	}
+	return count, status, nil
+}
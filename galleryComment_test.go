@@ -0,0 +1,35 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGalleryComments(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/3/gallery/ClF8rLe/comments/new":
+			fmt.Fprint(w, `{"data":[{"id":1,"comment":"Nice cat","children":[{"id":2,"parent_id":1,"comment":"Agreed","children":[]}]},{"id":3,"comment":"Meh","children":[]}],"success":true,"status":200}`)
+		case "/3/gallery/ClF8rLe/comments/count":
+			fmt.Fprint(w, `{"data":3,"success":true,"status":200}`)
+		default:
+			t.Errorf("unexpected request to %v", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	comments, status, err := client.GetGalleryComments(context.Background(), ImageID("ClF8rLe"), CommentsNew)
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Len(t, comments, 2)
+	require.Equal(t, "Agreed", comments[0].Children[0].Comment)
+
+	count, _, err := client.GetGalleryCommentCount(context.Background(), ImageID("ClF8rLe"))
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+}
@@ -0,0 +1,56 @@
+package imgur
+
+import "encoding/json"
+
+// GalleryItem is an entry of a gallery listing, which is either an image or an album.
+//
+//	if item.IsAlbum() {
+//		album := item.AsAlbum()
+//	} else {
+//		image := item.AsImage()
+//	}
+type GalleryItem struct {
+	image *GalleryImageInfo
+	album *GalleryAlbumInfo
+}
+
+// IsAlbum reports whether the item is an album
+func (item *GalleryItem) IsAlbum() bool {
+	return item.album != nil
+}
+
+// AsImage returns the item as image, nil if it is an album
+func (item *GalleryItem) AsImage() *GalleryImageInfo {
+	return item.image
+}
+
+// AsAlbum returns the item as album, nil if it is an image
+func (item *GalleryItem) AsAlbum() *GalleryAlbumInfo {
+	return item.album
+}
+
+// UnmarshalJSON decodes the item as album or image depending on its is_album field
+func (item *GalleryItem) UnmarshalJSON(data []byte) error {
+	var kind struct {
+		IsAlbum bool `json:"is_album"`
+	}
+	if err := json.Unmarshal(data, &kind); err != nil {
+		return err
+	}
+
+	*item = GalleryItem{}
+	if kind.IsAlbum {
+		item.album = &GalleryAlbumInfo{}
+		return json.Unmarshal(data, item.album)
+	}
+	item.image = &GalleryImageInfo{}
+	return json.Unmarshal(data, item.image)
+}
+
+// MarshalJSON encodes the image or album of the item
+func (item GalleryItem) MarshalJSON() ([]byte, error) {
+	if item.album != nil {
+		return json.Marshal(item.album)
+	}
+	return json.Marshal(item.image)
+}
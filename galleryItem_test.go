@@ -0,0 +1,35 @@
+package imgur
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGalleryItemJSON(t *testing.T) {
+	var items []GalleryItem
+	require.NoError(t, json.Unmarshal([]byte(`[{"id":"ClF8rLe","is_album":false,"width":1200},{"id":"VZQXk","is_album":true,"images":[{"id":"CJCA0gW"}]},{"id":"noflag"}]`), &items))
+	require.Len(t, items, 3)
+
+	require.False(t, items[0].IsAlbum())
+	require.Nil(t, items[0].AsAlbum())
+	require.Equal(t, 1200, items[0].AsImage().Width)
+
+	require.True(t, items[1].IsAlbum())
+	require.Nil(t, items[1].AsImage())
+	require.Equal(t, ImageID("CJCA0gW"), items[1].AsAlbum().Images[0].ID)
+
+	// items without is_album are images
+	require.Equal(t, ImageID("noflag"), items[2].AsImage().ID)
+
+	data, err := json.Marshal(items[:2])
+	require.NoError(t, err)
+	var decoded []GalleryItem
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, ImageID("ClF8rLe"), decoded[0].AsImage().ID)
+	require.Equal(t, AlbumID("VZQXk"), decoded[1].AsAlbum().ID)
+
+	var item GalleryItem
+	require.Error(t, json.Unmarshal([]byte(`[]`), &item))
+}
@@ -0,0 +1,181 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// GallerySort is the order of gallery listings
+type GallerySort string
+
+// Orders of gallery listings
+const (
+	SortTime   GallerySort = "time"   // Newest first
+	SortViral  GallerySort = "viral"  // Most viral first
+	SortTop    GallerySort = "top"    // Highest scoring first within the window
+	SortRising GallerySort = "rising" // Gaining popularity, only for the user section
+)
+
+// GalleryWindow is the time range of gallery listings sorted by SortTop
+type GalleryWindow string
+
+// Time ranges of gallery listings
+const (
+	WindowDay   GalleryWindow = "day"
+	WindowWeek  GalleryWindow = "week"
+	WindowMonth GalleryWindow = "month"
+	WindowYear  GalleryWindow = "year"
+	WindowAll   GalleryWindow = "all"
+)
+
+// SearchOptions control the order and page of search results
+type SearchOptions struct {
+	Sort   GallerySort   // Default is SortTime
+	Window GalleryWindow // Only used with SortTop, default is WindowAll
+	Page   int           // Page of the results, starting at 0
+}
+
+// SearchFileType restricts an advanced search to a type of file
+type SearchFileType string
+
+// File types of an advanced search
+const (
+	SearchJPG    SearchFileType = "jpg"
+	SearchPNG    SearchFileType = "png"
+	SearchGIF    SearchFileType = "gif"
+	SearchAnigif SearchFileType = "anigif"
+	SearchAlbum  SearchFileType = "album"
+)
+
+// SearchSize restricts an advanced search to a size of image
+type SearchSize string
+
+// Image sizes of an advanced search
+const (
+	SizeSmall  SearchSize = "small" // 500 pixels square or less
+	SizeMedium SearchSize = "med"   // 500 to 2,000 pixels square
+	SizeBig    SearchSize = "big"   // 2,000 to 5,000 pixels square
+	SizeLarge  SearchSize = "lrg"   // 5,000 to 10,000 pixels square
+	SizeHuge   SearchSize = "huge"  // 10,000 square pixels and above
+)
+
+// SearchQuery builds an advanced gallery search.
+//
+//	q := NewSearchQuery().All("cat", "dog").Not("bird").Type(SearchGIF)
+type SearchQuery struct {
+	all      []string
+	any      []string
+	exactly  string
+	not      []string
+	fileType SearchFileType
+	size     SearchSize
+}
+
+// NewSearchQuery creates an empty advanced search
+func NewSearchQuery() *SearchQuery {
+	return &SearchQuery{}
+}
+
+// All requires results to match all of words
+func (q *SearchQuery) All(words ...string) *SearchQuery {
+	q.all = append(q.all, words...)
+	return q
+}
+
+// Any requires results to match any of words
+func (q *SearchQuery) Any(words ...string) *SearchQuery {
+	q.any = append(q.any, words...)
+	return q
+}
+
+// Exactly requires results to contain phrase
+func (q *SearchQuery) Exactly(phrase string) *SearchQuery {
+	q.exactly = phrase
+	return q
+}
+
+// Not excludes results matching any of words
+func (q *SearchQuery) Not(words ...string) *SearchQuery {
+	q.not = append(q.not, words...)
+	return q
+}
+
+// Type restricts the results to a type of file
+func (q *SearchQuery) Type(fileType SearchFileType) *SearchQuery {
+	q.fileType = fileType
+	return q
+}
+
+// Size restricts the results to a size of image
+func (q *SearchQuery) Size(size SearchSize) *SearchQuery {
+	q.size = size
+	return q
+}
+
+func (q *SearchQuery) values() url.Values {
+	v := url.Values{}
+	if len(q.all) > 0 {
+		v.Set("q_all", strings.Join(q.all, " "))
+	}
+	if len(q.any) > 0 {
+		v.Set("q_any", strings.Join(q.any, " "))
+	}
+	if q.exactly != "" {
+		v.Set("q_exactly", q.exactly)
+	}
+	if len(q.not) > 0 {
+		v.Set("q_not", strings.Join(q.not, " "))
+	}
+	if q.fileType != "" {
+		v.Set("q_type", string(q.fileType))
+	}
+	if q.size != "" {
+		v.Set("q_size_px", string(q.size))
+	}
+	return v
+}
+
+// GallerySearch searches the gallery for query, which can use the boolean operators
+// and field prefixes of the imgur search.
+// returns the found images and albums, status code of the request, error
+func (client *Client) GallerySearch(ctx context.Context, query string, opts SearchOptions) ([]GalleryItem, int, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, -1, fmt.Errorf("Search query is empty")
+	}
+	return client.gallerySearch(ctx, url.Values{"q": {query}}, opts)
+}
+
+// GallerySearchAdvanced searches the gallery with an advanced query.
+// returns the found images and albums, status code of the request, error
+func (client *Client) GallerySearchAdvanced(ctx context.Context, query *SearchQuery, opts SearchOptions) ([]GalleryItem, int, error) {
+	if query == nil {
+		return nil, -1, fmt.Errorf("Search query is empty")
+	}
+	v := query.values()
+	if len(v) == 0 {
+		return nil, -1, fmt.Errorf("Search query is empty")
+	}
+	return client.gallerySearch(ctx, v, opts)
+}
+
+func (client *Client) gallerySearch(ctx context.Context, query url.Values, opts SearchOptions) ([]GalleryItem, int, error) {
+	sort := opts.Sort
+	if sort == "" {
+		sort = SortTime
+	}
+	window := opts.Window
+	if window == "" {
+		window = WindowAll
+	}
+
+	path := "gallery/search/" + string(sort) + "/" + string(window) + "/" + strconv.Itoa(opts.Page)
+	var items []GalleryItem
+	_, status, err := client.send(ctx, "GET", path, query, &items)
+	if err != nil {
+		return nil, status, fmt.Errorf("Problem searching the gallery - %w", err)
+	}
+	return items, status, nil
+}
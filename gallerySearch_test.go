@@ -0,0 +1,64 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const gallerySearchJSON = `{"data":[{"id":"ClF8rLe","title":"A cat","type":"image\/jpeg","is_album":false,"points":12},{"id":"VZQXk","title":"Cats","cover":"CJCA0gW","is_album":true,"images_count":2}],"success":true,"status":200}`
+
+func TestGallerySearch(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "GET", r.Method)
+		require.Equal(t, "/3/gallery/search/top/week/2", r.URL.Path)
+		require.Equal(t, "cats OR dogs", r.URL.Query().Get("q"))
+		fmt.Fprint(w, gallerySearchJSON)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	items, status, err := client.GallerySearch(context.Background(), "cats OR dogs", SearchOptions{Sort: SortTop, Window: WindowWeek, Page: 2})
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Len(t, items, 2)
+
+	require.Nil(t, items[0].AsAlbum())
+	require.Equal(t, ImageID("ClF8rLe"), items[0].AsImage().ID)
+	require.Equal(t, 12, items[0].AsImage().Points)
+
+	require.Nil(t, items[1].AsImage())
+	require.Equal(t, AlbumID("VZQXk"), items[1].AsAlbum().ID)
+	require.Equal(t, 2, items[1].AsAlbum().ImagesCount)
+
+	_, _, err = client.GallerySearch(context.Background(), " ", SearchOptions{})
+	require.Error(t, err)
+}
+
+func TestGallerySearchAdvanced(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/3/gallery/search/time/all/0", r.URL.Path)
+		q := r.URL.Query()
+		require.Equal(t, "cat dog", q.Get("q_all"))
+		require.Equal(t, "grumpy cat", q.Get("q_exactly"))
+		require.Equal(t, "bird", q.Get("q_not"))
+		require.Equal(t, "anigif", q.Get("q_type"))
+		require.Equal(t, "med", q.Get("q_size_px"))
+		require.NotContains(t, q, "q_any")
+		require.NotContains(t, q, "q")
+		fmt.Fprint(w, gallerySearchJSON)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	query := NewSearchQuery().All("cat", "dog").Exactly("grumpy cat").Not("bird").Type(SearchAnigif).Size(SizeMedium)
+	items, _, err := client.GallerySearchAdvanced(context.Background(), query, SearchOptions{})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+
+	_, _, err = client.GallerySearchAdvanced(context.Background(), NewSearchQuery(), SearchOptions{})
+	require.Error(t, err)
+}
@@ -0,0 +1,82 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// GalleryPostKind tells whether a gallery post is an image or an album
+type GalleryPostKind string
+
+// Kinds of gallery posts
+const (
+	PostImage GalleryPostKind = "image"
+	PostAlbum GalleryPostKind = "album"
+)
+
+// postKind returns whether post is an image or an album
+func postKind(post PostRef) GalleryPostKind {
+	if _, ok := post.(AlbumID); ok {
+		return PostAlbum
+	}
+	return PostImage
+}
+
+// ShareToGallery publishes an image or album of the user in the public gallery, which
+// requires an authenticated client. title is required, topic and tags may be empty.
+// Sharing accepts the terms of imgur for the post.
+// returns status code of the request, error
+func (client *Client) ShareToGallery(ctx context.Context, post PostRef, title string, topic string, mature bool, tags []string) (int, error) {
+	id := refString(post)
+	if id == "" {
+		return -1, fmt.Errorf("Gallery post ID is empty")
+	}
+	kind := postKind(post)
+	if strings.TrimSpace(title) == "" {
+		return -1, fmt.Errorf("Title for the gallery post %v is empty", id)
+	}
+	if !client.authenticated() {
+		return -1, fmt.Errorf("Sharing %v %v requires an access token - %w", kind, id, ErrUnauthorized)
+	}
+
+	v := url.Values{
+		"title": {title},
+		"terms": {"1"},
+	}
+	if topic != "" {
+		v.Set("topic", topic)
+	}
+	if mature {
+		v.Set("mature", "1")
+	}
+	if len(tags) > 0 {
+		v.Set("tags", strings.Join(tags, ","))
+	}
+
+	_, status, err := client.send(ctx, "POST", "gallery/"+string(kind)+"/"+id, v, nil)
+	if err != nil {
+		return status, fmt.Errorf("Problem sharing %v %v to the gallery - %w", kind, id, err)
+	}
+	return status, nil
+}
+
+// RemoveFromGallery removes a post of the user from the public gallery.
+// The image or album itself is not deleted.
+// returns status code of the request, error
+func (client *Client) RemoveFromGallery(ctx context.Context, post PostRef) (int, error) {
+	id := refString(post)
+	if id == "" {
+		return -1, fmt.Errorf("Gallery post ID is empty")
+	}
+	if !client.authenticated() {
+		return -1, fmt.Errorf("Removing gallery post %v requires an access token - %w", id, ErrUnauthorized)
+	}
+
+	_, status, err := client.send(ctx, "DELETE", "gallery/"+id, nil, nil)
+	if err != nil {
+		return status, fmt.Errorf("Problem removing %v from the gallery - %w", id, err)
+	}
+	return status, nil
+}
@@ -0,0 +1,54 @@
+package imgur
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShareToGallery(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "POST", r.Method)
+		require.Equal(t, "/3/gallery/album/VZQXk", r.URL.Path)
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "Cats", r.PostForm.Get("title"))
+		require.Equal(t, "Aww", r.PostForm.Get("topic"))
+		require.Equal(t, "1", r.PostForm.Get("terms"))
+		require.Equal(t, "cats,cute", r.PostForm.Get("tags"))
+		require.NotContains(t, r.PostForm, "mature")
+		fmt.Fprint(w, `{"data":true,"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	status, err := client.ShareToGallery(context.Background(), AlbumID("VZQXk"), "Cats", "Aww", false, []string{"cats", "cute"})
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+
+	_, err = client.ShareToGallery(context.Background(), ImageID("ClF8rLe"), "", "", false, nil)
+	require.Error(t, err)
+	_, err = client.ShareToGallery(context.Background(), nil, "Cats", "", false, nil)
+	require.Error(t, err)
+
+	anonymous, _ := NewClient(httpC, "testing", "")
+	_, err = anonymous.ShareToGallery(context.Background(), ImageID("ClF8rLe"), "Cats", "", false, nil)
+	require.True(t, errors.Is(err, ErrUnauthorized))
+}
+
+func TestRemoveFromGallery(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "DELETE", r.Method)
+		require.Equal(t, "/3/gallery/ClF8rLe", r.URL.Path)
+		fmt.Fprint(w, `{"data":true,"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	status, err := client.RemoveFromGallery(context.Background(), ImageID("ClF8rLe"))
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+}
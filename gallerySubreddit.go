@@ -0,0 +1,49 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// GetSubredditGallery lists a page of the images imgur collected from a subreddit, like "pics".
+// Empty sort or window default to SortTime and WindowWeek, the window only applies to SortTop.
+// returns the images and albums of the page, status code of the request, error
+func (client *Client) GetSubredditGallery(ctx context.Context, subreddit string, sort GallerySort, window GalleryWindow, page int) ([]GalleryItem, int, error) {
+	subreddit = strings.TrimPrefix(subreddit, "r/")
+	if strings.TrimSpace(subreddit) == "" {
+		return nil, -1, fmt.Errorf("Subreddit is empty")
+	}
+	if sort == "" {
+		sort = SortTime
+	}
+	if window == "" {
+		window = WindowWeek
+	}
+
+	path := "gallery/r/" + subreddit + "/" + string(sort) + "/" + string(window) + "/" + strconv.Itoa(page)
+	var items []GalleryItem
+	_, status, err := client.send(ctx, "GET", path, nil, &items)
+	if err != nil {
+		return nil, status, fmt.Errorf("Problem getting gallery of subreddit %v - %w", subreddit, err)
+	}
+	return items, status, nil
+}
+
+// GetSubredditImage queries imgur for an image of a subreddit gallery
+// returns image info, status code of the request, error
+func (client *Client) GetSubredditImage(ctx context.Context, subreddit string, imageID ImageID) (*GalleryImageInfo, int, error) {
+	subreddit = strings.TrimPrefix(subreddit, "r/")
+	if strings.TrimSpace(subreddit) == "" || refString(imageID) == "" {
+		return nil, -1, fmt.Errorf("Subreddit or image ID is empty")
+	}
+
+	img := &GalleryImageInfo{}
+	rl, status, err := client.send(ctx, "GET", "gallery/r/"+subreddit+"/"+string(imageID), nil, img)
+	if err != nil {
+		return nil, status, fmt.Errorf("Problem getting image %v of subreddit %v - %w", imageID, subreddit, err)
+	}
+	img.Limit = rl
+	return img, status, nil
+}
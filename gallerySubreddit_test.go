@@ -0,0 +1,44 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSubredditGallery(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/3/gallery/r/pics/top/month/3", r.URL.Path)
+		fmt.Fprint(w, gallerySearchJSON)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	items, status, err := client.GetSubredditGallery(context.Background(), "r/pics", SortTop, WindowMonth, 3)
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Len(t, items, 2)
+
+	_, _, err = client.GetSubredditGallery(context.Background(), "", "", "", 0)
+	require.Error(t, err)
+}
+
+func TestGetSubredditImage(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/3/gallery/r/pics/ClF8rLe", r.URL.Path)
+		w.Header().Set("X-RateLimit-ClientRemaining", "5")
+		fmt.Fprint(w, `{"data":{"id":"ClF8rLe","title":"A cat","section":"pics","is_album":false},"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	img, status, err := client.GetSubredditImage(context.Background(), "pics", "ClF8rLe")
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Equal(t, "A cat", img.Title)
+	require.Equal(t, "pics", img.Section)
+	require.Equal(t, int64(5), img.Limit.ClientRemaining)
+}
@@ -0,0 +1,99 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Tag contains the information imgur provides on a gallery tag
+type Tag struct {
+	Name           string        `json:"name"`            // Name of the tag
+	DisplayName    string        `json:"display_name"`    // Name of the tag as shown on imgur
+	Description    string        `json:"description"`     // Description of the tag
+	Followers      int           `json:"followers"`       // Number of users following the tag
+	TotalItems     int           `json:"total_items"`     // Total number of gallery posts with the tag
+	Following      bool          `json:"following"`       // If the current user follows the tag
+	BackgroundHash string        `json:"background_hash"` // ID of the background image of the tag
+	Items          []GalleryItem `json:"items,omitempty"` // Gallery posts with the tag, only set by GetTagGallery
+	Limit          *RateLimit    `json:"-"`               // Current rate limit
+}
+
+// GetTag queries imgur for information on a tag
+// returns the tag, status code of the request, error
+func (client *Client) GetTag(ctx context.Context, tagname string) (*Tag, int, error) {
+	if strings.TrimSpace(tagname) == "" {
+		return nil, -1, fmt.Errorf("Tag name is empty")
+	}
+
+	tag := &Tag{}
+	rl, status, err := client.send(ctx, "GET", "gallery/tag_info/"+tagname, nil, tag)
+	if err != nil {
+		return nil, status, fmt.Errorf("Problem getting tag %v - %w", tagname, err)
+	}
+	tag.Limit = rl
+	return tag, status, nil
+}
+
+// GetTagGallery lists a page of the gallery posts with a tag. An empty sort defaults to SortViral.
+// returns the tag including its posts, status code of the request, error
+func (client *Client) GetTagGallery(ctx context.Context, tagname string, sort GallerySort, page int) (*Tag, int, error) {
+	if strings.TrimSpace(tagname) == "" {
+		return nil, -1, fmt.Errorf("Tag name is empty")
+	}
+	if sort == "" {
+		sort = SortViral
+	}
+
+	tag := &Tag{}
+	rl, status, err := client.send(ctx, "GET", "gallery/t/"+tagname+"/"+string(sort)+"/"+strconv.Itoa(page), nil, tag)
+	if err != nil {
+		return nil, status, fmt.Errorf("Problem getting gallery of tag %v - %w", tagname, err)
+	}
+	tag.Limit = rl
+	return tag, status, nil
+}
+
+// UpdateGalleryTags replaces the tags of a gallery post of the user.
+// returns status code of the request, error
+func (client *Client) UpdateGalleryTags(ctx context.Context, post PostRef, tags []string) (int, error) {
+	postID := refString(post)
+	if postID == "" {
+		return -1, fmt.Errorf("Gallery post ID is empty")
+	}
+	if len(tags) == 0 {
+		return -1, fmt.Errorf("No tags given for gallery post %v", postID)
+	}
+	if !client.authenticated() {
+		return -1, fmt.Errorf("Tagging gallery post %v requires an access token - %w", postID, ErrUnauthorized)
+	}
+
+	_, status, err := client.send(ctx, "POST", "gallery/tags/"+postID, url.Values{"tags": {strings.Join(tags, ",")}}, nil)
+	if err != nil {
+		return status, fmt.Errorf("Problem updating tags of gallery post %v - %w", postID, err)
+	}
+	return status, nil
+}
+
+// VoteGalleryTag votes on whether a tag fits a gallery post. Only VoteUp and VoteDown are accepted.
+// returns status code of the request, error
+func (client *Client) VoteGalleryTag(ctx context.Context, post PostRef, tagname string, vote Vote) (int, error) {
+	postID := refString(post)
+	if postID == "" || strings.TrimSpace(tagname) == "" {
+		return -1, fmt.Errorf("Gallery post ID or tag name is empty")
+	}
+	if vote != VoteUp && vote != VoteDown {
+		return -1, fmt.Errorf("Invalid tag vote %q", vote)
+	}
+	if !client.authenticated() {
+		return -1, fmt.Errorf("Voting on tag %v requires an access token - %w", tagname, ErrUnauthorized)
+	}
+
+	_, status, err := client.send(ctx, "POST", "gallery/"+postID+"/vote/tag/"+tagname+"/"+string(vote), nil, nil)
+	if err != nil {
+		return status, fmt.Errorf("Problem voting on tag %v of gallery post %v - %w", tagname, postID, err)
+	}
+	return status, nil
+}
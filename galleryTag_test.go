@@ -0,0 +1,66 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTag(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/3/gallery/tag_info/cats", r.URL.Path)
+		fmt.Fprint(w, `{"data":{"name":"cats","display_name":"Cats","followers":1000,"total_items":42,"following":false,"background_hash":"CJCA0gW"},"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	tag, status, err := client.GetTag(context.Background(), "cats")
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Equal(t, "Cats", tag.DisplayName)
+	require.Equal(t, 42, tag.TotalItems)
+	require.Len(t, tag.Items, 0)
+}
+
+func TestGetTagGallery(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/3/gallery/t/cats/top/1", r.URL.Path)
+		fmt.Fprint(w, `{"data":{"name":"cats","total_items":2,"items":[{"id":"ClF8rLe","is_album":false},{"id":"VZQXk","is_album":true}]},"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	tag, _, err := client.GetTagGallery(context.Background(), "cats", SortTop, 1)
+	require.NoError(t, err)
+	require.Len(t, tag.Items, 2)
+	require.True(t, tag.Items[1].IsAlbum())
+}
+
+func TestUpdateAndVoteGalleryTags(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "POST", r.Method)
+		switch r.URL.Path {
+		case "/3/gallery/tags/ClF8rLe":
+			require.NoError(t, r.ParseForm())
+			require.Equal(t, "cats,cute", r.PostForm.Get("tags"))
+		case "/3/gallery/ClF8rLe/vote/tag/cats/down":
+		default:
+			t.Errorf("unexpected request to %v", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"data":true,"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	_, err := client.UpdateGalleryTags(context.Background(), ImageID("ClF8rLe"), []string{"cats", "cute"})
+	require.NoError(t, err)
+
+	_, err = client.VoteGalleryTag(context.Background(), ImageID("ClF8rLe"), "cats", VoteDown)
+	require.NoError(t, err)
+
+	_, err = client.VoteGalleryTag(context.Background(), ImageID("ClF8rLe"), "cats", VoteVeto)
+	require.Error(t, err)
+}
@@ -0,0 +1,61 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+)
+
+// Vote is a vote on a gallery post
+type Vote string
+
+// Votes on a gallery post
+const (
+	VoteUp   Vote = "up"
+	VoteDown Vote = "down"
+	VoteVeto Vote = "veto" // Removes an earlier vote
+)
+
+// GalleryVotes is the vote breakdown of a gallery post
+type GalleryVotes struct {
+	Ups   int        `json:"ups"`   // Number of upvotes
+	Downs int        `json:"downs"` // Number of downvotes
+	Limit *RateLimit `json:"-"`     // Current rate limit
+}
+
+// VoteGallery votes on a gallery post, which requires an authenticated client.
+// returns status code of the request, error
+func (client *Client) VoteGallery(ctx context.Context, post PostRef, vote Vote) (int, error) {
+	id := refString(post)
+	if id == "" {
+		return -1, fmt.Errorf("Gallery post ID is empty")
+	}
+	if vote != VoteUp && vote != VoteDown && vote != VoteVeto {
+		return -1, fmt.Errorf("Invalid vote %q", vote)
+	}
+	if !client.authenticated() {
+		return -1, fmt.Errorf("Voting on gallery post %v requires an access token - %w", id, ErrUnauthorized)
+	}
+
+	_, status, err := client.send(ctx, "POST", "gallery/"+id+"/vote/"+string(vote), nil, nil)
+	if err != nil {
+		return status, fmt.Errorf("Problem voting on gallery post %v - %w", id, err)
+	}
+	return status, nil
+}
+
+// GetGalleryVotes queries imgur for the votes of a gallery post
+// returns the votes, status code of the request, error
+func (client *Client) GetGalleryVotes(ctx context.Context, post PostRef) (*GalleryVotes, int, error) {
+	id := refString(post)
+	if id == "" {
+		return nil, -1, fmt.Errorf("Gallery post ID is empty")
+	}
+
+	votes := &GalleryVotes{}
+	rl, status, err := client.send(ctx, "GET", "gallery/"+id+"/votes", nil, votes)
+	if err != nil {
+		return nil, status, fmt.Errorf("Problem getting votes of gallery post %v - %w", id, err)
+	}
+	votes.Limit = rl
+	return votes, status, nil
+}
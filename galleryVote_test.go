@@ -0,0 +1,48 @@
+package imgur
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVoteGallery(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "POST", r.Method)
+		require.Equal(t, "/3/gallery/ClF8rLe/vote/up", r.URL.Path)
+		fmt.Fprint(w, `{"data":true,"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	status, err := client.VoteGallery(context.Background(), ImageID("ClF8rLe"), VoteUp)
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+
+	_, err = client.VoteGallery(context.Background(), ImageID("ClF8rLe"), "sideways")
+	require.Error(t, err)
+
+	anonymous, _ := NewClient(httpC, "testing", "")
+	_, err = anonymous.VoteGallery(context.Background(), ImageID("ClF8rLe"), VoteDown)
+	require.True(t, errors.Is(err, ErrUnauthorized))
+}
+
+func TestGetGalleryVotes(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "GET", r.Method)
+		require.Equal(t, "/3/gallery/ClF8rLe/votes", r.URL.Path)
+		fmt.Fprint(w, `{"data":{"ups":1204,"downs":25},"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	votes, status, err := client.GetGalleryVotes(context.Background(), ImageID("ClF8rLe"))
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Equal(t, 1204, votes.Ups)
+	require.Equal(t, 25, votes.Downs)
+}
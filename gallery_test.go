@@ -0,0 +1,71 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGallery(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "GET", r.Method)
+		require.Equal(t, "/3/gallery/user/rising/week/1", r.URL.Path)
+		require.Equal(t, "true", r.URL.Query().Get("showViral"))
+		require.Equal(t, "false", r.URL.Query().Get("mature"))
+		fmt.Fprint(w, gallerySearchJSON)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	items, status, err := client.GetGallery(context.Background(), SectionUser, SortRising, WindowWeek, 1, true, false)
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Len(t, items, 2)
+	require.Equal(t, ImageID("ClF8rLe"), items[0].AsImage().ID)
+	require.Equal(t, AlbumID("VZQXk"), items[1].AsAlbum().ID)
+}
+
+func TestGetGalleryDefaults(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/3/gallery/hot/viral/day/0", r.URL.Path)
+		fmt.Fprint(w, `{"data":[],"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	items, _, err := client.GetGallery(context.Background(), "", "", "", 0, false, false)
+	require.NoError(t, err)
+	require.Len(t, items, 0)
+}
+
+func TestGalleryIterator(t *testing.T) {
+	pages := map[string]string{
+		"/3/gallery/top/top/all/0": `[{"id":"ClF8rLe","title":"cat","is_album":false},{"id":"VZQXk","title":"cats","is_album":true}]`,
+		"/3/gallery/top/top/all/1": `[{"id":"asd","title":"dog","is_album":false}]`,
+		"/3/gallery/top/top/all/2": `[]`,
+	}
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		page, ok := pages[r.URL.Path]
+		require.True(t, ok, r.URL.Path)
+		require.Equal(t, "true", r.URL.Query().Get("mature"))
+		fmt.Fprint(w, `{"data":`+page+`,"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	it := client.Gallery(SectionTop, SortTop, WindowAll, false, true)
+	defer it.Close()
+	var titles []string
+	for it.Next(context.Background()) {
+		if it.Item().IsAlbum() {
+			titles = append(titles, it.Item().AsAlbum().Title)
+		} else {
+			titles = append(titles, it.Item().AsImage().Title)
+		}
+	}
+	require.NoError(t, it.Err())
+	require.Equal(t, []string{"cat", "cats", "dog"}, titles)
+}
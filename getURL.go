@@ -1,51 +1,88 @@
 package imgur
 
 import (
-	"errors"
-	"io/ioutil"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
 )
 
 func (client *Client) createAPIURL(u string) string {
+	if client.baseURL != "" {
+		return client.baseURL + u
+	}
 	if client.rapidAPIKey == "" {
 		return apiEndpoint + u
 	}
 	return apiEndpointRapidAPI + u
 }
 
+// createRootURL returns the URL of an endpoint outside of the versioned API, like "oauth2/token".
+// RapidAPI only serves the versioned API, so these endpoints are always requested from imgur
+// unless a base URL is set.
+func (client *Client) createRootURL(u string) string {
+	if client.baseURL == "" {
+		return apiEndpointRoot + u
+	}
+	base, err := url.Parse(client.baseURL)
+	if err != nil {
+		return apiEndpointRoot + u
+	}
+	// the OAuth endpoints are next to the versioned API
+	return base.ResolveReference(&url.URL{Path: "../" + u}).String()
+}
+
+// newRequest creates a request to imgur, authenticated with the access token if
+// one is set and ctx is not anonymous, and with the client ID otherwise
+func (client *Client) newRequest(ctx context.Context, method string, URL string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, URL, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if token := client.accessToken(); token != "" && !isAnonymous(ctx) {
+		req.Header.Set("Authorization", "Bearer "+token)
+	} else {
+		req.Header.Set("Authorization", "Client-ID "+client.imgurAccount.clientID)
+	}
+	if client.rapidAPIKey != "" {
+		req.Header.Set("x-rapidapi-host", rapidAPIHost)
+		req.Header.Set("x-rapidapi-key", client.rapidAPIKey)
+	}
+	return req, nil
+}
+
 // getURL returns
 // - body as string
 // - RateLimit with current limits
 // - error in case something broke
-func (client *Client) getURL(URL string) (string, *RateLimit, error) {
+func (client *Client) getURL(ctx context.Context, URL string) (string, *RateLimit, error) {
 	URL = client.createAPIURL(URL)
 	client.Log.Infof("Requesting URL %v\n", URL)
-	req, err := http.NewRequest("GET", URL, nil)
+	req, err := client.newRequest(ctx, "GET", URL, nil)
 	if err != nil {
-		return "", nil, errors.New("Could not create request for " + URL + " - " + err.Error())
-	}
-
-	req.Header.Add("Authorization", "Client-ID "+client.imgurAccount.clientID)
-	if client.rapidAPIKey != "" {
-		req.Header.Add("x-rapidapi-host", "imgur-apiv3.p.rapidapi.com")
-		req.Header.Add("x-rapidapi-key", client.rapidAPIKey)
+		return "", nil, fmt.Errorf("Could not create request for %v - %w", URL, err)
 	}
 
 	// Make a request to the sourceURL
-	res, err := client.httpClient.Do(req)
+	res, err := client.do(req)
 	if err != nil {
-		return "", nil, errors.New("Could not get " + URL + " - " + err.Error())
+		return "", nil, fmt.Errorf("Could not get %v - %w", URL, err)
 	}
 	defer res.Body.Close()
 
-	if !(res.StatusCode >= 200 && res.StatusCode <= 300) {
-		return "", nil, errors.New("HTTP status indicates an error for " + URL + " - " + res.Status)
-	}
-
 	// Read the whole body
-	body, err := ioutil.ReadAll(res.Body)
+	body, err := client.readBody(res.Body)
 	if err != nil {
-		return "", nil, errors.New("Problem reading the body for " + URL + " - " + err.Error())
+		return "", nil, fmt.Errorf("Problem reading the body for %v - %w", URL, err)
+	}
+
+	if !(res.StatusCode >= 200 && res.StatusCode <= 300) || isErrorPage(body) {
+		return "", nil, NewAPIError(req.Method, URL, res.StatusCode, body)
 	}
 
 	// Get RateLimit headers
@@ -56,3 +93,225 @@ func (client *Client) getURL(URL string) (string, *RateLimit, error) {
 
 	return string(body[:]), rl, nil
 }
+
+// dataWrapper is the envelope of all imgur responses
+type dataWrapper struct {
+	Data    interface{} `json:"data"`
+	Success bool        `json:"success"`
+	Status  int         `json:"status"`
+}
+
+// Do calls an API endpoint this package has no method for, e.g.
+// client.Do(ctx, "GET", "account/me/images/count", nil, &count).
+// The request is authenticated, retried and throttled like all requests of the client.
+// params are sent form-encoded for POST and PUT and in the query otherwise, paths starting
+// with "/" are outside of the versioned API. The data of the response is decoded into v,
+// which may be nil.
+// returns the rate limits, status reported by imgur, error
+func (client *Client) Do(ctx context.Context, method string, path string, params url.Values, v interface{}) (*RateLimit, int, error) {
+	if strings.TrimSpace(method) == "" {
+		return nil, -1, fmt.Errorf("HTTP method is empty")
+	}
+	return client.send(ctx, strings.ToUpper(method), path, params, v)
+}
+
+// send requests the API path with params and decodes the data of the response into v,
+// which may be nil. params are sent form-encoded for POST and PUT and in the
+// query otherwise. Paths starting with "/" are outside of the versioned API.
+// It returns the rate limits and the status reported by imgur.
+func (client *Client) send(ctx context.Context, method string, path string, params url.Values, v interface{}) (*RateLimit, int, error) {
+	URL := client.createAPIURL(path)
+	if strings.HasPrefix(path, "/") {
+		URL = client.createRootURL(path[1:])
+	}
+	var body io.Reader
+	if method == http.MethodPost || method == http.MethodPut {
+		body = strings.NewReader(params.Encode())
+	} else if len(params) > 0 {
+		URL += "?" + params.Encode()
+	}
+
+	client.Log.Infof("Requesting %v %v\n", method, URL)
+	req, err := client.newRequest(ctx, method, URL, body)
+	if err != nil {
+		return nil, -1, fmt.Errorf("Could not create request for %v - %w", URL, err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	res, err := client.do(req)
+	if err != nil {
+		return nil, -1, fmt.Errorf("Could not %v %v - %w", method, URL, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 200 && res.StatusCode <= 300 && !client.rawJSON {
+		return client.decodeStream(res, method, URL, v)
+	}
+
+	raw, err := client.readBody(res.Body)
+	if err != nil {
+		return nil, -1, fmt.Errorf("Problem reading the body for %v - %w", URL, err)
+	}
+	if !(res.StatusCode >= 200 && res.StatusCode <= 300) || isErrorPage(raw) {
+		return nil, res.StatusCode, NewAPIError(method, URL, res.StatusCode, raw)
+	}
+
+	rl, err := extractRateLimits(res.Header)
+	if err != nil {
+		client.Log.Infof("Problem with extracting rate limits: %v", err)
+	}
+
+	wrapper := dataWrapper{Data: v}
+	if err := json.Unmarshal(raw, &wrapper); err != nil {
+		return rl, -1, fmt.Errorf("Problem decoding json for %v - %w", URL, err)
+	}
+	if wrapper.Status == 0 {
+		wrapper.Status = res.StatusCode
+	}
+	if !wrapper.Success {
+		return rl, wrapper.Status, NewAPIError(method, URL, wrapper.Status, raw)
+	}
+	client.keepRawJSON(raw, v)
+	return rl, wrapper.Status, nil
+}
+
+// decodeStream decodes the successful response res into v while the body is read, like send.
+// Listings decoded into a slice are decoded item by item, so only one item is buffered at a
+// time. Other data is buffered on its own before it is decoded, but not the whole response.
+func (client *Client) decodeStream(res *http.Response, method string, URL string, v interface{}) (*RateLimit, int, error) {
+	body, page, err := jsonBody(limitBody(res.Body, client.maxResponseSize))
+	if err != nil {
+		return nil, -1, fmt.Errorf("Problem reading the body for %v - %w", URL, err)
+	}
+	if page != nil {
+		return nil, res.StatusCode, NewAPIError(method, URL, res.StatusCode, page)
+	}
+
+	rl, err := extractRateLimits(res.Header)
+	if err != nil {
+		client.Log.Infof("Problem with extracting rate limits: %v", err)
+	}
+
+	dec := json.NewDecoder(body)
+	var success bool
+	var status int
+	var data json.RawMessage // data that is not decoded item by item
+	if err := expectDelim(dec, '{'); err != nil {
+		return rl, -1, fmt.Errorf("Problem decoding json for %v - %w", URL, err)
+	}
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return rl, -1, fmt.Errorf("Problem decoding json for %v - %w", URL, err)
+		}
+		switch key {
+		case "data":
+			if items, ok := sliceTarget(v); ok {
+				data, err = decodeItems(dec, items)
+			} else {
+				err = dec.Decode(&data)
+			}
+		case "success":
+			err = dec.Decode(&success)
+		case "status":
+			err = dec.Decode(&status)
+		default:
+			err = dec.Decode(new(json.RawMessage))
+		}
+		if err != nil {
+			return rl, -1, fmt.Errorf("Problem decoding json for %v - %w", URL, err)
+		}
+	}
+	if err := expectDelim(dec, '}'); err != nil {
+		return rl, -1, fmt.Errorf("Problem decoding json for %v - %w", URL, err)
+	}
+
+	if status == 0 {
+		status = res.StatusCode
+	}
+	if !success {
+		raw, _ := json.Marshal(map[string]json.RawMessage{"data": data})
+		return rl, status, NewAPIError(method, URL, status, raw)
+	}
+	if data != nil && v != nil {
+		if err := json.Unmarshal(data, v); err != nil {
+			return rl, -1, fmt.Errorf("Problem decoding json for %v - %w", URL, err)
+		}
+	}
+	return rl, status, nil
+}
+
+// sliceTarget returns the slice v points to, if v is a pointer to a slice that is decoded
+// from a JSON array without custom unmarshaling
+func sliceTarget(v interface{}) (reflect.Value, bool) {
+	if _, ok := v.(json.Unmarshaler); ok || v == nil {
+		return reflect.Value{}, false
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Slice || rv.Elem().Type().Elem().Kind() == reflect.Uint8 {
+		return reflect.Value{}, false
+	}
+	return rv.Elem(), true
+}
+
+// decodeItems decodes the array read by dec into the slice items one item at a time.
+// Anything but an array is returned instead, it is the error of an unsuccessful response
+// or null.
+func decodeItems(dec *json.Decoder, items reflect.Value) (json.RawMessage, error) {
+	t, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch t {
+	case json.Delim('['):
+	case json.Delim('{'):
+		return readObject(dec)
+	default:
+		return json.Marshal(t)
+	}
+
+	items.Set(reflect.MakeSlice(items.Type(), 0, 0))
+	zero := reflect.Zero(items.Type().Elem())
+	for dec.More() {
+		// decode into the appended item, so it is not copied
+		items.Set(reflect.Append(items, zero))
+		if err := dec.Decode(items.Index(items.Len() - 1).Addr().Interface()); err != nil {
+			return nil, err
+		}
+	}
+	return nil, expectDelim(dec, ']')
+}
+
+// readObject reads the rest of an object whose opening { was already read by dec
+func readObject(dec *json.Decoder) (json.RawMessage, error) {
+	fields := map[string]json.RawMessage{}
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, err
+		}
+		fields[fmt.Sprint(key)] = value
+	}
+	if err := expectDelim(dec, '}'); err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
+
+// expectDelim reads the next token of dec, which has to be delim
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	t, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if t != delim {
+		return fmt.Errorf("expected %v, got %v", delim, t)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package imgur
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDo(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer access", r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/3/account/me/images/count":
+			require.Equal(t, "GET", r.Method)
+			require.Equal(t, "1", r.URL.Query().Get("page"))
+			w.Header().Set("X-RateLimit-ClientRemaining", "5")
+			fmt.Fprint(w, `{"data":7,"success":true,"status":200}`)
+		case "/3/image/ClF8rLe":
+			require.Equal(t, "POST", r.Method)
+			require.NoError(t, r.ParseForm())
+			require.Equal(t, "Cat", r.PostForm.Get("title"))
+			fmt.Fprint(w, `{"data":true,"success":true,"status":200}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"data":{"error":"Not found"},"success":false,"status":404}`)
+		}
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	var count int
+	rl, status, err := client.Do(context.Background(), "get", "account/me/images/count", url.Values{"page": {"1"}}, &count)
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Equal(t, 7, count)
+	require.Equal(t, int64(5), rl.ClientRemaining)
+
+	_, status, err = client.Do(context.Background(), "POST", "image/ClF8rLe", url.Values{"title": {"Cat"}}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+
+	_, status, err = client.Do(context.Background(), "GET", "unknown", nil, nil)
+	require.True(t, errors.Is(err, ErrNotFound))
+	require.Equal(t, 404, status)
+
+	_, _, err = client.Do(context.Background(), "", "image/ClF8rLe", nil, nil)
+	require.Error(t, err)
+}
@@ -1,6 +1,6 @@
 module github.com/koffeinsource/go-imgur
 
-go 1.14
+go 1.16
 
 require (
 	github.com/koffeinsource/go-klogger v0.1.1
@@ -70,6 +70,19 @@ func testHTTPClientInvalidJSON() (*http.Client, *httptest.Server) {
 	return http.DefaultClient, server
 }
 
+// testHTTPClientHandler lets the test inspect the request before it answers it
+func testHTTPClientHandler(handler http.HandlerFunc) (*http.Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		log.Fatalln("failed to parse httptest.Server URL:", err)
+	}
+	http.DefaultClient.Transport = rewriteTransport{URL: u}
+
+	return http.DefaultClient, server
+}
+
 type rewriteTransport struct {
 	Transport http.RoundTripper
 	URL       *url.URL
@@ -0,0 +1,82 @@
+package imgur
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ImageID is the ID imgur assigns to an image, like "ClF8rLe"
+type ImageID string
+
+// AlbumID is the ID imgur assigns to an album, like "VZQXk"
+type AlbumID string
+
+// DeleteHash is the secret returned with an anonymous upload or album. It is the
+// only way to change or delete the anonymous image or album later.
+type DeleteHash string
+
+// CommentID is the ID imgur assigns to a comment
+type CommentID int
+
+// ImageRef refers to an image, either by its ImageID or, for anonymous uploads, its DeleteHash
+type ImageRef interface {
+	fmt.Stringer
+	imageRef()
+}
+
+// AlbumRef refers to an album, either by its AlbumID or, for anonymous albums, its DeleteHash
+type AlbumRef interface {
+	fmt.Stringer
+	albumRef()
+}
+
+// PostRef refers to a gallery post, which is an ImageID or an AlbumID
+type PostRef interface {
+	fmt.Stringer
+	postRef()
+}
+
+func (id ImageID) String() string   { return string(id) }
+func (id AlbumID) String() string   { return string(id) }
+func (h DeleteHash) String() string { return string(h) }
+func (id CommentID) String() string { return strconv.Itoa(int(id)) }
+
+func (ImageID) imageRef()    {}
+func (ImageID) postRef()     {}
+func (AlbumID) albumRef()    {}
+func (AlbumID) postRef()     {}
+func (DeleteHash) imageRef() {}
+func (DeleteHash) albumRef() {}
+
+// ImageIDs converts ids to ImageIDs
+func ImageIDs(ids ...string) []ImageID {
+	r := make([]ImageID, len(ids))
+	for i, id := range ids {
+		r[i] = ImageID(id)
+	}
+	return r
+}
+
+// DeleteHashes converts hashes to DeleteHashes
+func DeleteHashes(hashes ...string) []DeleteHash {
+	r := make([]DeleteHash, len(hashes))
+	for i, h := range hashes {
+		r[i] = DeleteHash(h)
+	}
+	return r
+}
+
+// ParseCommentID converts the decimal representation of a comment ID
+func ParseCommentID(s string) (CommentID, error) {
+	id, err := strconv.Atoi(s)
+	return CommentID(id), err
+}
+
+// refString returns the ID of ref for use in a path, "" if ref is nil or blank
+func refString(ref fmt.Stringer) string {
+	if ref == nil {
+		return ""
+	}
+	return strings.TrimSpace(ref.String())
+}
@@ -0,0 +1,31 @@
+package imgur
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIDConversions(t *testing.T) {
+	require.Equal(t, []ImageID{"a", "b"}, ImageIDs("a", "b"))
+	require.Equal(t, []DeleteHash{"x"}, DeleteHashes("x"))
+	require.Equal(t, "42", CommentID(42).String())
+
+	id, err := ParseCommentID("42")
+	require.NoError(t, err)
+	require.Equal(t, CommentID(42), id)
+	_, err = ParseCommentID("abc")
+	require.Error(t, err)
+
+	require.Equal(t, "", refString(nil))
+	require.Equal(t, "", refString(ImageID(" ")))
+	require.Equal(t, "VZQXk", refString(AlbumID("VZQXk")))
+}
+
+func TestPostKind(t *testing.T) {
+	require.Equal(t, PostImage, postKind(ImageID("ClF8rLe")))
+	require.Equal(t, PostAlbum, postKind(AlbumID("VZQXk")))
+
+	c := Comment{ImageID: "VZQXk", OnAlbum: true}
+	require.Equal(t, AlbumID("VZQXk"), c.Post())
+}
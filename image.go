@@ -1,10 +1,11 @@
 package imgur
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
-	"strconv"
+	"fmt"
 	"strings"
+	"time"
 )
 
 type imageInfoDataWrapper struct {
@@ -15,50 +16,89 @@ type imageInfoDataWrapper struct {
 
 // ImageInfo contains all image information provided by imgur
 type ImageInfo struct {
-	ID          string     `json:"id"`                   // The ID for the image
-	Title       string     `json:"title"`                // The title of the image.
-	Description string     `json:"description"`          // Description of the image.
-	Datetime    int        `json:"datetime"`             // Time uploaded, epoch time
-	MimeType    string     `json:"type"`                 // Image MIME type.
-	Animated    bool       `json:"animated"`             // is the image animated
-	Width       int        `json:"width"`                // The width of the image in pixels
-	Height      int        `json:"height"`               // The height of the image in pixels
-	Size        int        `json:"size"`                 // The size of the image in bytes
-	Views       int        `json:"views"`                // The number of image views
-	Bandwidth   int        `json:"bandwidth"`            // Bandwidth consumed by the image in bytes
-	Deletehash  string     `json:"deletehash,omitempty"` // OPTIONAL, the deletehash, if you're logged in as the image owner
-	Name        string     `json:"name,omitempty"`       // OPTIONAL, the original filename, if you're logged in as the image owner
-	Section     string     `json:"section"`              // If the image has been categorized by our backend then this will contain the section the image belongs in. (funny, cats, adviceanimals, wtf, etc)
-	Link        string     `json:"link"`                 // The direct link to the the image. (Note: if fetching an animated GIF that was over 20MB in original size, a .gif thumbnail will be returned)
-	Gifv        string     `json:"gifv,omitempty"`       // OPTIONAL, The .gifv link. Only available if the image is animated and type is 'image/gif'.
-	Mp4         string     `json:"mp4,omitempty"`        // OPTIONAL, The direct link to the .mp4. Only available if the image is animated and type is 'image/gif'.
-	Mp4Size     int        `json:"mp4_size,omitempty"`   // OPTIONAL, The Content-Length of the .mp4. Only available if the image is animated and type is 'image/gif'. Note that a zero value (0) is possible if the video has not yet been generated
-	Looping     bool       `json:"looping,omitempty"`    // OPTIONAL, Whether the image has a looping animation. Only available if the image is animated and type is 'image/gif'.
-	Favorite    bool       `json:"favorite"`             // Indicates if the current user favorited the image. Defaults to false if not signed in.
-	Nsfw        bool       `json:"nsfw"`                 // Indicates if the image has been marked as nsfw or not. Defaults to null if information is not available.
-	Vote        string     `json:"vote"`                 // The current user's vote on the album. null if not signed in, if the user hasn't voted on it, or if not submitted to the gallery.
-	InGallery   bool       `json:"in_gallery"`           // True if the image has been submitted to the gallery, false if otherwise.
-	HasSound    bool       `json:"has_sound"`            // Indicates if the video has sound.
-	Limit       *RateLimit // Current rate limit
+	ID            ImageID         `json:"id"`                   // The ID for the image
+	Title         string          `json:"title"`                // The title of the image.
+	Description   string          `json:"description"`          // Description of the image.
+	Datetime      UnixTime        `json:"datetime"`             // Time uploaded, epoch time
+	MimeType      string          `json:"type"`                 // Image MIME type.
+	Animated      bool            `json:"animated"`             // is the image animated
+	Width         int             `json:"width"`                // The width of the image in pixels
+	Height        int             `json:"height"`               // The height of the image in pixels
+	Size          int             `json:"size"`                 // The size of the image in bytes
+	Views         int             `json:"views"`                // The number of image views
+	Bandwidth     int             `json:"bandwidth"`            // Bandwidth consumed by the image in bytes
+	Deletehash    DeleteHash      `json:"deletehash,omitempty"` // OPTIONAL, the deletehash, if you're logged in as the image owner
+	Name          string          `json:"name,omitempty"`       // OPTIONAL, the original filename, if you're logged in as the image owner
+	Section       string          `json:"section"`              // If the image has been categorized by our backend then this will contain the section the image belongs in. (funny, cats, adviceanimals, wtf, etc)
+	Link          string          `json:"link"`                 // The direct link to the the image. (Note: if fetching an animated GIF that was over 20MB in original size, a .gif thumbnail will be returned)
+	Gifv          string          `json:"gifv,omitempty"`       // OPTIONAL, The .gifv link. Only available if the image is animated and type is 'image/gif'.
+	Mp4           string          `json:"mp4,omitempty"`        // OPTIONAL, The direct link to the .mp4. Only available if the image is animated and type is 'image/gif'.
+	Mp4Size       int             `json:"mp4_size,omitempty"`   // OPTIONAL, The Content-Length of the .mp4. Only available if the image is animated and type is 'image/gif'. Note that a zero value (0) is possible if the video has not yet been generated
+	Looping       bool            `json:"looping,omitempty"`    // OPTIONAL, Whether the image has a looping animation. Only available if the image is animated and type is 'image/gif'.
+	Favorite      bool            `json:"favorite"`             // Indicates if the current user favorited the image. Defaults to false if not signed in.
+	Nsfw          bool            `json:"nsfw"`                 // Indicates if the image has been marked as nsfw or not. Defaults to null if information is not available.
+	Vote          string          `json:"vote"`                 // The current user's vote on the album. null if not signed in, if the user hasn't voted on it, or if not submitted to the gallery.
+	InGallery     bool            `json:"in_gallery"`           // True if the image has been submitted to the gallery, false if otherwise.
+	HasSound      bool            `json:"has_sound"`            // Indicates if the video has sound.
+	Hls           string          `json:"hls,omitempty"`        // OPTIONAL, The link to the HLS playlist of a video.
+	AccountURL    string          `json:"account_url"`          // The username of the account that uploaded it, or null.
+	AccountID     int             `json:"account_id"`           // The account ID of the account that uploaded it, or null.
+	Edited        UnixTime        `json:"edited"`               // Time of the last edit, epoch time, 0 if the image was never edited.
+	InMostViral   bool            `json:"in_most_viral"`        // Indicates if the image is in the most viral gallery or not.
+	FavoriteCount int             `json:"favorite_count"`       // Number of users that favorited the image.
+	Tags          []Tag           `json:"tags"`                 // The gallery tags of the image.
+	IsAd          bool            `json:"is_ad"`                // Indicates if the image is an ad.
+	AdType        int             `json:"ad_type"`              // The type of ad, 0 if the image is not an ad.
+	AdURL         string          `json:"ad_url"`               // The link of the ad, empty if the image is not an ad.
+	AdConfig      *AdConfig       `json:"ad_config,omitempty"`  // OPTIONAL, how imgur shows ads next to the image.
+	Processing    *Processing     `json:"processing,omitempty"` // OPTIONAL, the state of the conversion imgur runs after a video or gif upload.
+	Limit         *RateLimit      // Current rate limit
+	Raw           json.RawMessage `json:"-"` // The JSON sent by imgur, only kept with WithRawJSON
+}
+
+// CreatedAt returns when the image was uploaded
+func (ii *ImageInfo) CreatedAt() time.Time {
+	return ii.Datetime.Time()
+}
+
+// EditedAt returns when the image was edited last, the zero time.Time if it never was
+func (ii *ImageInfo) EditedAt() time.Time {
+	return ii.Edited.Time()
+}
+
+// AdConfig describes how imgur shows ads next to an image or album
+type AdConfig struct {
+	SafeFlags       []string `json:"safeFlags"`
+	HighRiskFlags   []string `json:"highRiskFlags"`
+	UnsafeFlags     []string `json:"unsafeFlags"`
+	WallUnsafeFlags []string `json:"wallUnsafeFlags"`
+	ShowsAds        bool     `json:"showsAds"`
 }
 
 // GetImageInfo queries imgur for information on a image
 // returns image info, status code of the request, error
-func (client *Client) GetImageInfo(id string) (*ImageInfo, int, error) {
-	body, rl, err := client.getURL("image/" + id)
+func (client *Client) GetImageInfo(id ImageID) (*ImageInfo, int, error) {
+	return client.GetImageInfoWithContext(context.Background(), id)
+}
+
+// GetImageInfoWithContext is like GetImageInfo, but the request is bound to ctx
+func (client *Client) GetImageInfoWithContext(ctx context.Context, id ImageID) (*ImageInfo, int, error) {
+	path := "image/" + string(id)
+	body, rl, err := client.getURL(ctx, path)
 	if err != nil {
-		return nil, -1, errors.New("Problem getting URL for image info ID " + id + " - " + err.Error())
+		return nil, -1, fmt.Errorf("Problem getting URL for image info ID %v - %w", id, err)
 	}
 
 	dec := json.NewDecoder(strings.NewReader(body))
 	var img imageInfoDataWrapper
 	if err := dec.Decode(&img); err != nil {
-		return nil, -1, errors.New("Problem decoding json for imageID " + id + " - " + err.Error())
+		return nil, -1, fmt.Errorf("Problem decoding json for imageID %v - %w", id, err)
 	}
-	img.Ii.Limit = rl
 
 	if !img.Success {
-		return nil, img.Status, errors.New("Request to imgur failed for imageID " + id + " - " + strconv.Itoa(img.Status))
+		return nil, img.Status, fmt.Errorf("Request to imgur failed for imageID %v - %w", id, NewAPIError("GET", client.createAPIURL(path), img.Status, []byte(body)))
 	}
+	img.Ii.Limit = rl
+	client.keepRawJSON([]byte(body), img.Ii)
 	return img.Ii, img.Status, nil
 }
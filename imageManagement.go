@@ -0,0 +1,84 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// DeleteResult is returned by requests deleting something on imgur
+type DeleteResult struct {
+	Deleted bool       // True if imgur confirmed the deletion
+	Limit   *RateLimit // Current rate limit
+}
+
+// DeleteImage deletes the image with the given ImageID, or DeleteHash for anonymous uploads.
+// returns the result, status code of the request, error
+func (client *Client) DeleteImage(ctx context.Context, image ImageRef) (*DeleteResult, int, error) {
+	idOrDeleteHash := refString(image)
+	if idOrDeleteHash == "" {
+		return nil, -1, fmt.Errorf("Image ID is empty")
+	}
+	result := &DeleteResult{}
+	rl, status, err := client.send(ctx, "DELETE", "image/"+idOrDeleteHash, nil, &result.Deleted)
+	if err != nil {
+		return nil, status, fmt.Errorf("Problem deleting image %v - %w", idOrDeleteHash, err)
+	}
+	result.Limit = rl
+	return result, status, nil
+}
+
+// BulkDeleteResult is the outcome of deleting one image with DeleteImages
+type BulkDeleteResult struct {
+	ID      string // The ImageID or DeleteHash of the image
+	Deleted bool   // True if imgur confirmed the deletion
+	Status  int    // Status code of the request
+	Err     error  // Why the image was not deleted
+}
+
+// DeleteImages deletes the images with the given ImageIDs, or DeleteHashes for anonymous
+// uploads, with a bounded number of parallel requests. Once the user credits are used up,
+// requests wait for the reset unless the client is set up to reject them with WithThrottle.
+// Images not deleted before ctx is done fail with its error.
+// returns one result per image in the order of idsOrDeleteHashes
+func (client *Client) DeleteImages(ctx context.Context, idsOrDeleteHashes ...string) []BulkDeleteResult {
+	ctx = client.bulkContext(ctx)
+	results := make([]BulkDeleteResult, len(idsOrDeleteHashes))
+	runBulk(ctx, len(idsOrDeleteHashes), 0, func(i int) {
+		// IDs and deletehashes share the endpoint, the type of the reference does not matter
+		result, status, err := client.DeleteImage(ctx, DeleteHash(idsOrDeleteHashes[i]))
+		results[i] = BulkDeleteResult{ID: idsOrDeleteHashes[i], Status: status, Err: err}
+		if result != nil {
+			results[i].Deleted = result.Deleted
+		}
+	}, func(i int) {
+		results[i] = BulkDeleteResult{ID: idsOrDeleteHashes[i], Status: -1, Err: ctx.Err()}
+	})
+	return results
+}
+
+// UpdateImage changes the title and description of the image with the given ImageID, or
+// DeleteHash for anonymous uploads. Empty values are left unchanged.
+// returns status code of the request, error
+func (client *Client) UpdateImage(ctx context.Context, image ImageRef, title string, description string) (int, error) {
+	idOrDeleteHash := refString(image)
+	if idOrDeleteHash == "" {
+		return -1, fmt.Errorf("Image ID is empty")
+	}
+	v := url.Values{}
+	if title != "" {
+		v.Set("title", title)
+	}
+	if description != "" {
+		v.Set("description", description)
+	}
+	if len(v) == 0 {
+		return -1, fmt.Errorf("Neither title nor description given for image %v", idOrDeleteHash)
+	}
+
+	_, status, err := client.send(ctx, "POST", "image/"+idOrDeleteHash, v, nil)
+	if err != nil {
+		return status, fmt.Errorf("Problem updating image %v - %w", idOrDeleteHash, err)
+	}
+	return status, nil
+}
@@ -0,0 +1,123 @@
+package imgur
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteImage(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "DELETE", r.Method)
+		if r.URL.Path != "/3/image/hash" {
+			w.WriteHeader(404)
+			fmt.Fprint(w, `{"data":{"error":"Unable to find an image with the id, missing","request":"\/3\/image\/missing","method":"DELETE"},"success":false,"status":404}`)
+			return
+		}
+		fmt.Fprint(w, `{"data":true,"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	result, status, err := client.DeleteImage(context.Background(), DeleteHash("hash"))
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.True(t, result.Deleted)
+
+	_, status, err = client.DeleteImage(context.Background(), DeleteHash("missing"))
+	require.True(t, errors.Is(err, ErrNotFound))
+	require.Equal(t, 404, status)
+
+	_, _, err = client.DeleteImage(context.Background(), DeleteHash(" "))
+	require.Error(t, err)
+}
+
+func TestDeleteImages(t *testing.T) {
+	var running, maxRunning int32
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		require.Equal(t, "DELETE", r.Method)
+		if r.URL.Path == "/3/image/missing" {
+			w.WriteHeader(404)
+			fmt.Fprint(w, `{"data":{"error":"Unable to find an image with the id, missing","request":"\/3\/image\/missing","method":"DELETE"},"success":false,"status":404}`)
+			return
+		}
+		fmt.Fprint(w, `{"data":true,"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	ids := []string{"a", "missing", "b", "", "c", "d", "e", "f"}
+	results := client.DeleteImages(context.Background(), ids...)
+	require.Len(t, results, len(ids))
+	for i, result := range results {
+		require.Equal(t, ids[i], result.ID)
+		switch ids[i] {
+		case "missing":
+			require.True(t, errors.Is(result.Err, ErrNotFound))
+			require.Equal(t, 404, result.Status)
+			require.False(t, result.Deleted)
+		case "":
+			require.Error(t, result.Err)
+			require.Equal(t, -1, result.Status)
+		default:
+			require.NoError(t, result.Err)
+			require.Equal(t, 200, result.Status)
+			require.True(t, result.Deleted)
+		}
+	}
+	require.LessOrEqual(t, atomic.LoadInt32(&maxRunning), int32(defaultBulkConcurrency))
+}
+
+func TestDeleteImagesWaitsForCredits(t *testing.T) {
+	var requests int
+	httpC, closeServer := testHTTPClientCredits(0, 12000, time.Now().Add(time.Hour), &requests)
+	defer closeServer()
+
+	client, _ := NewClient(httpC, "testing", "")
+	_, _, err := client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	results := client.DeleteImages(ctx, "a", "b")
+	for _, result := range results {
+		require.ErrorIs(t, result.Err, context.DeadlineExceeded)
+	}
+	require.Equal(t, 1, requests)
+}
+
+func TestUpdateImage(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "POST", r.Method)
+		require.Equal(t, "/3/image/hash", r.URL.Path)
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "Fixed title", r.PostForm.Get("title"))
+		require.NotContains(t, r.PostForm, "description")
+		fmt.Fprint(w, `{"data":true,"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	status, err := client.UpdateImage(context.Background(), DeleteHash("hash"), "Fixed title", "")
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+
+	_, err = client.UpdateImage(context.Background(), DeleteHash("hash"), "", "")
+	require.Error(t, err)
+}
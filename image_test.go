@@ -1,9 +1,15 @@
 package imgur
 
 import (
-	"net/http"
+	"encoding/json"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/koffeinsource/go-imgur/imgurtest/vcr"
+	"github.com/stretchr/testify/require"
 )
 
 func TestImageImgurSimulated(t *testing.T) {
@@ -27,14 +33,25 @@ func TestImageImgurSimulated(t *testing.T) {
 	}
 }
 
+// TestImageImgurReal replays test_data/cassettes/image.json, run it with IMGUR_RECORD=1
+// and IMGURCLIENTID set to record the cassette again
 func TestImageImgurReal(t *testing.T) {
+	mode := vcr.ModeFromEnv()
 	key := os.Getenv("IMGURCLIENTID")
-	if key == "" {
+	if mode == vcr.ModeRecord && key == "" {
 		t.Skip("IMGURCLIENTID environment variable not set.")
 	}
+	if key == "" {
+		key = "replay"
+	}
 	RapidAPIKey := os.Getenv("RapidAPIKEY")
 
-	client, _ := NewClient(new(http.Client), key, RapidAPIKey)
+	rec, err := vcr.New("test_data/cassettes/image.json", mode)
+	require.NoError(t, err)
+	rec.Secrets = []string{key, RapidAPIKey}
+	defer func() { require.NoError(t, rec.Save()) }()
+
+	client, _ := NewClient(rec.Client(), key, RapidAPIKey)
 
 	img, status, err := client.GetImageInfo("ClF8rLe")
 
@@ -51,3 +68,55 @@ func TestImageImgurReal(t *testing.T) {
 		t.Fail()
 	}
 }
+
+// capturedVideoJSON is the answer of imgur for GET /3/image/{id} of a video uploaded by a user
+const capturedVideoJSON = `{"data":{"id":"xZ3bTd2","title":"Cat","description":"A cat jumping","datetime":1697040000,"type":"video\/mp4","animated":true,"width":720,"height":1280,"size":5242880,"views":1234,"bandwidth":6471811072,"vote":null,"favorite":false,"nsfw":false,"section":null,"account_url":"Locker","account_id":42,"is_ad":false,"in_most_viral":true,"has_sound":true,"tags":[{"name":"cats","display_name":"Cats","followers":100,"total_items":5000,"following":false,"background_hash":"abc","description":"cats and kittens"}],"ad_type":0,"ad_url":"","edited":"1697040100","in_gallery":true,"link":"https:\/\/i.imgur.com\/xZ3bTd2.mp4","mp4":"https:\/\/i.imgur.com\/xZ3bTd2.mp4","gifv":"https:\/\/i.imgur.com\/xZ3bTd2.gifv","hls":"https:\/\/i.imgur.com\/xZ3bTd2.m3u8","mp4_size":5242880,"looping":true,"processing":{"status":"completed"},"favorite_count":7,"ad_config":{"safeFlags":["in_gallery","sixth_mod_safe"],"highRiskFlags":[],"unsafeFlags":["mature"],"wallUnsafeFlags":[],"showsAds":false}},"success":true,"status":200}`
+
+// jsonKeys returns the names of the JSON fields of the struct type t
+func jsonKeys(t reflect.Type) map[string]bool {
+	keys := map[string]bool{}
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			keys[name] = true
+		}
+	}
+	return keys
+}
+
+func TestImageInfoFieldCoverage(t *testing.T) {
+	var raw struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(capturedVideoJSON), &raw))
+	known := jsonKeys(reflect.TypeOf(ImageInfo{}))
+	for key := range raw.Data {
+		require.True(t, known[key], "ImageInfo does not decode %v", key)
+	}
+
+	httpC, server := testHTTPClientJSON(capturedVideoJSON)
+	defer server.Close()
+	client, _ := NewClient(httpC, "testing", "")
+	img, _, err := client.GetImageInfo("xZ3bTd2")
+	require.NoError(t, err)
+	require.Equal(t, "https://i.imgur.com/xZ3bTd2.m3u8", img.Hls)
+	require.Equal(t, "https://i.imgur.com/xZ3bTd2.mp4", img.Mp4)
+	require.Equal(t, 5242880, img.Mp4Size)
+	require.True(t, img.HasSound)
+	require.True(t, img.InMostViral)
+	require.Equal(t, "Locker", img.AccountURL)
+	require.Equal(t, 42, img.AccountID)
+	require.Equal(t, time.Unix(1697040100, 0), img.EditedAt())
+	require.Equal(t, time.Unix(1697040000, 0), img.CreatedAt())
+	require.Equal(t, 7, img.FavoriteCount)
+	require.Equal(t, ProcessingCompleted, img.Processing.Status)
+	require.Equal(t, "cats", img.Tags[0].Name)
+	require.Equal(t, []string{"mature"}, img.AdConfig.UnsafeFlags)
+	require.Zero(t, img.AdType)
+	require.Empty(t, img.Vote)
+
+	// edited is a number for some images
+	var numeric ImageInfo
+	require.NoError(t, json.Unmarshal([]byte(`{"edited":0}`), &numeric))
+	require.True(t, numeric.EditedAt().IsZero())
+}
@@ -0,0 +1,91 @@
+package imgur
+
+import (
+	"context"
+	"sync"
+)
+
+// imagesInfoConcurrency is the number of parallel requests of GetImagesInfo
+const imagesInfoConcurrency = 8
+
+// ImageInfoResult is the outcome of one lookup of GetImagesInfo
+type ImageInfoResult struct {
+	ID     ImageID    // The requested ID
+	Image  *ImageInfo // The image, nil if the request failed
+	Status int        // Status code of the request
+	Err    error      // Why the request failed
+}
+
+// GetImagesInfo queries imgur for several images in parallel. Every distinct ID is requested
+// once, also if another GetImagesInfo call is requesting it at the same time, so results for
+// the same ID share the ImageInfo. A shared request is bound to the context of the call that started it.
+// returns one result per ID in the order of ids
+func (client *Client) GetImagesInfo(ctx context.Context, ids ...ImageID) []ImageInfoResult {
+	results := make([]ImageInfoResult, len(ids))
+	positions := map[ImageID][]int{}
+	var distinct []ImageID
+	for i, id := range ids {
+		if _, ok := positions[id]; !ok {
+			distinct = append(distinct, id)
+		}
+		positions[id] = append(positions[id], i)
+	}
+
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < imagesInfoConcurrency && w < len(distinct); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				id := distinct[i]
+				r := client.imageFlights.do(string(id), func() interface{} {
+					img, status, err := client.GetImageInfoWithContext(ctx, id)
+					return ImageInfoResult{ID: id, Image: img, Status: status, Err: err}
+				}).(ImageInfoResult)
+				for _, pos := range positions[id] {
+					results[pos] = r
+				}
+			}
+		}()
+	}
+	for i := range distinct {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+	return results
+}
+
+// flightGroup runs a function once for all concurrent callers asking for the same key
+type flightGroup struct {
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+type flight struct {
+	done  chan struct{}
+	value interface{}
+}
+
+func (g *flightGroup) do(key string, fn func() interface{}) interface{} {
+	g.mu.Lock()
+	if g.flights == nil {
+		g.flights = map[string]*flight{}
+	}
+	if f, ok := g.flights[key]; ok {
+		g.mu.Unlock()
+		<-f.done
+		return f.value
+	}
+	f := &flight{done: make(chan struct{})}
+	g.flights[key] = f
+	g.mu.Unlock()
+
+	f.value = fn()
+	g.mu.Lock()
+	delete(g.flights, key)
+	g.mu.Unlock()
+	close(f.done)
+	return f.value
+}
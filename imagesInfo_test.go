@@ -0,0 +1,78 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetImagesInfo(t *testing.T) {
+	var mu sync.Mutex
+	requests := map[string]int{}
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/3/image/")
+		mu.Lock()
+		requests[id]++
+		mu.Unlock()
+		if id == "missing" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"data":{"error":"Unable to find an image"},"success":false,"status":404}`)
+			return
+		}
+		fmt.Fprintf(w, `{"data":{"id":"%v"},"success":true,"status":200}`, id)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	ids := ImageIDs("a", "b", "missing", "a", "c", "b", "d", "e", "f", "g", "h", "i", "j")
+	results := client.GetImagesInfo(context.Background(), ids...)
+	require.Len(t, results, len(ids))
+	for i, result := range results {
+		require.Equal(t, ids[i], result.ID)
+		if ids[i] == "missing" {
+			require.ErrorIs(t, result.Err, ErrNotFound)
+			require.Nil(t, result.Image)
+			continue
+		}
+		require.NoError(t, result.Err)
+		require.Equal(t, ids[i], result.Image.ID)
+	}
+	require.Same(t, results[0].Image, results[3].Image)
+	for id, n := range requests {
+		require.Equal(t, 1, n, id)
+	}
+	require.Empty(t, client.GetImagesInfo(context.Background()))
+}
+
+func TestFlightGroup(t *testing.T) {
+	var g flightGroup
+	started := make(chan struct{})
+	release := make(chan struct{})
+	first := make(chan interface{})
+	go func() {
+		first <- g.do("key", func() interface{} {
+			close(started)
+			<-release
+			return 1
+		})
+	}()
+	<-started
+
+	second := make(chan interface{})
+	go func() {
+		second <- g.do("key", func() interface{} { return 2 })
+	}()
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	require.Equal(t, 1, <-first)
+	require.Equal(t, 1, <-second)
+
+	// a finished flight is not reused
+	require.Equal(t, 3, g.do("key", func() interface{} { return 3 }))
+}
@@ -0,0 +1,105 @@
+package imgur
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// URLKind is the kind of content an imgur link points to
+type URLKind string
+
+// Kinds of imgur links
+const (
+	URLImage   URLKind = "image"   // An image page or direct link, like https://imgur.com/ClF8rLe
+	URLAlbum   URLKind = "album"   // An album, like https://imgur.com/a/VZQXk
+	URLGallery URLKind = "gallery" // A gallery post, which is an image or an album
+)
+
+// ImgurURL is an imgur link as recognized by ParseURL
+type ImgurURL struct {
+	Kind      URLKind // What the link points to
+	ID        string  // The ID of the image, album or gallery post
+	Direct    bool    // True for direct links to the file on i.imgur.com
+	Extension string  // The file extension of a direct link, like ".jpg"
+}
+
+// imgurHosts are the hosts of imgur links, the value tells if the host serves direct links
+var imgurHosts = map[string]bool{
+	"imgur.com":     false,
+	"www.imgur.com": false,
+	"m.imgur.com":   false,
+	"i.imgur.com":   true,
+	"imgur.io":      false,
+	"www.imgur.io":  false,
+	"m.imgur.io":    false,
+	"i.imgur.io":    true,
+}
+
+// reservedPaths are the first path segments of imgur.com pages that are not images
+var reservedPaths = map[string]bool{
+	"a":       true,
+	"gallery": true,
+	"t":       true,
+	"r":       true,
+	"user":    true,
+	"upload":  true,
+}
+
+// ParseURL recognizes the direct, image, album and gallery links of imgur.com and imgur.io.
+// The scheme may be omitted, slugs in front of the ID of album and gallery links are removed.
+func ParseURL(raw string) (*ImgurURL, error) {
+	raw = strings.TrimSpace(raw)
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("Problem parsing URL %v - %w", raw, err)
+	}
+	direct, ok := imgurHosts[strings.ToLower(u.Hostname())]
+	if !ok {
+		return nil, fmt.Errorf("URL %v is not an imgur link", raw)
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	var parsed ImgurURL
+	switch {
+	case direct && len(segments) == 1:
+		parsed.Kind, parsed.Direct = URLImage, true
+		parsed.Extension = path.Ext(segments[0])
+		parsed.ID = strings.TrimSuffix(segments[0], parsed.Extension)
+	case len(segments) == 2 && segments[0] == "a":
+		parsed.Kind, parsed.ID = URLAlbum, slugID(segments[1])
+	case len(segments) == 2 && segments[0] == "gallery":
+		parsed.Kind, parsed.ID = URLGallery, slugID(segments[1])
+	case !direct && len(segments) == 1 && !reservedPaths[segments[0]]:
+		parsed.Kind, parsed.ID = URLImage, segments[0]
+	}
+	if parsed.Kind == "" || !validID(parsed.ID) {
+		return nil, fmt.Errorf("Could not find an ID in imgur link %v", raw)
+	}
+	return &parsed, nil
+}
+
+// slugID returns the ID at the end of a slug like "gianluca-giminis-bikes-VZQXk"
+func slugID(slug string) string {
+	if pos := strings.LastIndex(slug, "-"); pos != -1 {
+		return slug[pos+1:]
+	}
+	return slug
+}
+
+// validID reports whether id only consists of the alphanumeric characters used by imgur
+func validID(id string) bool {
+	if id == "" {
+		return false
+	}
+	for _, r := range id {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
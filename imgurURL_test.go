@@ -0,0 +1,41 @@
+package imgur
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseURL(t *testing.T) {
+	tests := []struct {
+		raw      string
+		expected ImgurURL
+	}{
+		{"https://i.imgur.com/ClF8rLe.jpg", ImgurURL{Kind: URLImage, ID: "ClF8rLe", Direct: true, Extension: ".jpg"}},
+		{"http://i.imgur.io/xZ3bTd2.mp4?1", ImgurURL{Kind: URLImage, ID: "xZ3bTd2", Direct: true, Extension: ".mp4"}},
+		{"https://imgur.com/ClF8rLe", ImgurURL{Kind: URLImage, ID: "ClF8rLe"}},
+		{"m.imgur.com/ClF8rLe/", ImgurURL{Kind: URLImage, ID: "ClF8rLe"}},
+		{"https://imgur.com/a/gianluca-giminis-bikes-VZQXk", ImgurURL{Kind: URLAlbum, ID: "VZQXk"}},
+		{"https://imgur.io/a/VZQXk", ImgurURL{Kind: URLAlbum, ID: "VZQXk"}},
+		{"https://imgur.com/gallery/abandoned-chinese-fishing-village-uPI76jY#comments", ImgurURL{Kind: URLGallery, ID: "uPI76jY"}},
+		{" https://m.imgur.io/gallery/VZQXk ", ImgurURL{Kind: URLGallery, ID: "VZQXk"}},
+	}
+	for _, test := range tests {
+		parsed, err := ParseURL(test.raw)
+		require.NoError(t, err, test.raw)
+		require.Equal(t, test.expected, *parsed, test.raw)
+	}
+
+	for _, raw := range []string{
+		"",
+		"https://example.com/ClF8rLe",
+		"https://imgur.com/",
+		"https://imgur.com/a/",
+		"https://imgur.com/user/Locker/posts",
+		"https://i.imgur.com/.jpg",
+		"https://imgur.com/gallery/%zz",
+	} {
+		_, err := ParseURL(raw)
+		require.Error(t, err, raw)
+	}
+}
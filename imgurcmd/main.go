@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/koffeinsource/go-imgur"
+	"github.com/koffeinsource/go-klogger"
 )
 
 func printRate(client *imgur.Client) {
@@ -20,7 +21,7 @@ func printRate(client *imgur.Client) {
 
 func printImage(client *imgur.Client, image *string) {
 	client.Log.Infof("*** IMAGE ***\n")
-	img, _, err := client.GetImageInfo(*image)
+	img, _, err := client.GetImageInfo(imgur.ImageID(*image))
 	if err != nil {
 		client.Log.Errorf("Error in GetImageInfo: %v\n", err)
 		return
@@ -30,7 +31,7 @@ func printImage(client *imgur.Client, image *string) {
 
 func printAlbum(client *imgur.Client, album *string) {
 	client.Log.Infof("*** ALBUM ***\n")
-	img, _, err := client.GetAlbumInfo(*album)
+	img, _, err := client.GetAlbumInfo(imgur.AlbumID(*album))
 	if err != nil {
 		client.Log.Errorf("Error in GetAlbumInfo: %v\n", err)
 		return
@@ -40,7 +41,7 @@ func printAlbum(client *imgur.Client, album *string) {
 
 func printGImage(client *imgur.Client, gimage *string) {
 	client.Log.Infof("*** GALLERY IMAGE ***\n")
-	img, _, err := client.GetGalleryImageInfo(*gimage)
+	img, _, err := client.GetGalleryImageInfo(imgur.ImageID(*gimage))
 	if err != nil {
 		client.Log.Errorf("Error in GetGalleryImageInfo: %v\n", err)
 		return
@@ -50,7 +51,7 @@ func printGImage(client *imgur.Client, gimage *string) {
 
 func printGAlbum(client *imgur.Client, galbum *string) {
 	client.Log.Infof("*** GALLERY ALBUM ***\n")
-	img, _, err := client.GetGalleryAlbumInfo(*galbum)
+	img, _, err := client.GetGalleryAlbumInfo(imgur.AlbumID(*galbum))
 	if err != nil {
 		client.Log.Errorf("Error in GetGalleryAlbumInfo: %v\n", err)
 		return
@@ -88,7 +89,7 @@ func main() {
 		return
 	}
 
-	client, err := imgur.NewClient(new(http.Client), *imgurClientID, "")
+	client, err := imgur.NewClientWithLogger(new(klogger.CLILogger), new(http.Client), *imgurClientID, "")
 	if err != nil {
 		fmt.Printf("failed during imgur client creation. %+v\n", err)
 		return
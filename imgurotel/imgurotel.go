@@ -0,0 +1,83 @@
+// Package imgurotel traces the requests of an imgur client with OpenTelemetry.
+//
+//	client, err := imgur.New(clientID, imgurotel.WithTracerProvider(tp))
+//
+// Every API request gets a client span named after its method and endpoint, like
+// "GET image/{id}". The span records the status code of the response, the number of
+// retries and the credits imgur reported as remaining.
+// The package is a module of its own, so the imgur package does not depend on OpenTelemetry.
+package imgurotel
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/koffeinsource/go-imgur"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName is the name of the tracer used for the spans
+const ScopeName = "github.com/koffeinsource/go-imgur/imgurotel"
+
+// Attributes of the spans, besides the HTTP attributes of the OpenTelemetry semantic conventions
+const (
+	EndpointKey        = attribute.Key("imgur.endpoint")                   // The endpoint, like "image/{id}"
+	UserRemainingKey   = attribute.Key("imgur.ratelimit.user_remaining")   // Remaining user credits
+	ClientRemainingKey = attribute.Key("imgur.ratelimit.client_remaining") // Remaining client credits
+)
+
+// WithTracerProvider traces all API requests of the client with a tracer of tp.
+// The global tracer provider is used if tp is nil.
+func WithTracerProvider(tp trace.TracerProvider) imgur.ClientOption {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	return imgur.WithCallHook(&tracer{tracer: tp.Tracer(ScopeName)})
+}
+
+// tracer is the imgur.CallHook starting and ending the spans
+type tracer struct {
+	tracer trace.Tracer
+}
+
+func (t *tracer) StartCall(ctx context.Context, method string, endpoint string) context.Context {
+	ctx, _ = t.tracer.Start(ctx, method+" "+endpoint,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", method),
+			EndpointKey.String(endpoint),
+		),
+	)
+	return ctx
+}
+
+func (t *tracer) EndCall(ctx context.Context, info imgur.CallInfo) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	span.SetAttributes(attribute.String("url.full", info.URL))
+	if info.Attempts > 1 {
+		span.SetAttributes(attribute.Int("http.request.resend_count", info.Attempts-1))
+	}
+	if info.RateLimit != nil {
+		span.SetAttributes(
+			UserRemainingKey.Int64(info.RateLimit.UserRemaining),
+			ClientRemainingKey.Int64(info.RateLimit.ClientRemaining),
+		)
+	}
+
+	switch {
+	case info.Err != nil:
+		span.RecordError(info.Err)
+		span.SetStatus(codes.Error, info.Err.Error())
+	case info.StatusCode >= 400:
+		span.SetStatus(codes.Error, strconv.Itoa(info.StatusCode)+" "+http.StatusText(info.StatusCode))
+	}
+	if info.StatusCode != 0 {
+		span.SetAttributes(attribute.Int("http.response.status_code", info.StatusCode))
+	}
+}
@@ -0,0 +1,88 @@
+package imgurotel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/koffeinsource/go-imgur"
+	"github.com/koffeinsource/go-imgur/imgurtest"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func attributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+func TestWithTracerProvider(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	srv := imgurtest.NewServer()
+	defer srv.Close()
+	srv.Inject(imgurtest.Fault{Path: "/3/credits", Status: 503, Times: 1})
+	client, err := srv.NewClient(WithTracerProvider(tp), imgur.WithRetryPolicy(imgur.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}))
+	require.NoError(t, err)
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+	_, _, err = client.GetCredits(ctx)
+	require.NoError(t, err)
+	_, _, err = client.GetImageInfoWithContext(ctx, "missing")
+	require.Error(t, err)
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+
+	credits := spans[0]
+	require.Equal(t, "GET credits", credits.Name())
+	require.Equal(t, trace.SpanKindClient, credits.SpanKind())
+	require.Equal(t, parent.SpanContext().SpanID(), credits.Parent().SpanID())
+	require.Equal(t, ScopeName, credits.InstrumentationScope().Name)
+	attrs := attributes(credits)
+	require.Equal(t, "GET", attrs["http.request.method"].AsString())
+	require.Equal(t, "credits", attrs[EndpointKey].AsString())
+	require.Equal(t, int64(200), attrs["http.response.status_code"].AsInt64())
+	require.Equal(t, int64(1), attrs["http.request.resend_count"].AsInt64())
+	require.Equal(t, int64(imgurtest.DefaultUserLimit), attrs[UserRemainingKey].AsInt64())
+	require.Equal(t, int64(imgurtest.DefaultClientLimit), attrs[ClientRemainingKey].AsInt64())
+	require.Equal(t, codes.Unset, credits.Status().Code)
+
+	image := spans[1]
+	require.Equal(t, "GET image/{id}", image.Name())
+	attrs = attributes(image)
+	require.Equal(t, int64(404), attrs["http.response.status_code"].AsInt64())
+	require.Equal(t, int64(imgurtest.DefaultUserLimit-1), attrs[UserRemainingKey].AsInt64())
+	_, retried := attrs["http.request.resend_count"]
+	require.False(t, retried)
+	require.Equal(t, codes.Error, image.Status().Code)
+	require.Equal(t, "404 Not Found", image.Status().Description)
+}
+
+func TestTransportError(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	srv := imgurtest.NewServer()
+	client, err := srv.NewClient(WithTracerProvider(tp), imgur.WithRetryPolicy(imgur.NoRetry))
+	require.NoError(t, err)
+	srv.Close()
+
+	_, _, err = client.GetCredits(context.Background())
+	require.Error(t, err)
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	require.Equal(t, codes.Error, spans[0].Status().Code)
+	require.Len(t, spans[0].Events(), 1)
+	_, ok := attributes(spans[0])["http.response.status_code"]
+	require.False(t, ok)
+}
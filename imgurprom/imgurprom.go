@@ -0,0 +1,86 @@
+// Package imgurprom exports metrics of the requests of an imgur client to Prometheus.
+//
+//	metrics, err := imgurprom.New(prometheus.DefaultRegisterer)
+//	client, err := imgur.New(clientID, imgur.WithMetrics(metrics))
+//
+// The package is a module of its own, so the imgur package does not depend on Prometheus.
+package imgurprom
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/koffeinsource/go-imgur"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics is an imgur.MetricsRecorder exporting the metrics:
+//
+//	imgur_requests_total{method,endpoint,code}        counter of the API requests, code is 0 if no response was received
+//	imgur_request_duration_seconds{method,endpoint}   histogram of the request durations, including retries
+//	imgur_sent_bytes_total{method,endpoint}           counter of the bytes sent in request bodies, e.g. by uploads
+//	imgur_ratelimit_user_remaining                    gauge of the user credits imgur reported last
+//	imgur_ratelimit_client_remaining                  gauge of the client credits imgur reported last
+type Metrics struct {
+	requests        *prometheus.CounterVec
+	duration        *prometheus.HistogramVec
+	sentBytes       *prometheus.CounterVec
+	userRemaining   prometheus.Gauge
+	clientRemaining prometheus.Gauge
+}
+
+var _ imgur.MetricsRecorder = (*Metrics)(nil)
+
+// New creates the metrics and registers them with reg.
+// prometheus.DefaultRegisterer is used if reg is nil.
+func New(reg prometheus.Registerer) (*Metrics, error) {
+	if reg == nil {
+		reg = prometheus.DefaultRegisterer
+	}
+	m := &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "imgur_requests_total",
+			Help: "Number of requests to the imgur API.",
+		}, []string{"method", "endpoint", "code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "imgur_request_duration_seconds",
+			Help:    "Duration of the requests to the imgur API, including retries.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "endpoint"}),
+		sentBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "imgur_sent_bytes_total",
+			Help: "Number of bytes sent in the bodies of requests to the imgur API.",
+		}, []string{"method", "endpoint"}),
+		userRemaining: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "imgur_ratelimit_user_remaining",
+			Help: "Remaining user credits reported by imgur.",
+		}),
+		clientRemaining: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "imgur_ratelimit_client_remaining",
+			Help: "Remaining client credits reported by imgur.",
+		}),
+	}
+	for _, c := range []prometheus.Collector{m.requests, m.duration, m.sentBytes, m.userRemaining, m.clientRemaining} {
+		if err := reg.Register(c); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// ObserveRequest counts the request and records its duration
+func (m *Metrics) ObserveRequest(method string, endpoint string, status int, duration time.Duration) {
+	m.requests.WithLabelValues(method, endpoint, strconv.Itoa(status)).Inc()
+	m.duration.WithLabelValues(method, endpoint).Observe(duration.Seconds())
+}
+
+// ObserveBytesSent adds bytes to the sent bytes of the endpoint
+func (m *Metrics) ObserveBytesSent(method string, endpoint string, bytes int64) {
+	m.sentBytes.WithLabelValues(method, endpoint).Add(float64(bytes))
+}
+
+// ObserveRateLimit sets the gauges of the remaining credits
+func (m *Metrics) ObserveRateLimit(rl imgur.RateLimit) {
+	m.userRemaining.Set(float64(rl.UserRemaining))
+	m.clientRemaining.Set(float64(rl.ClientRemaining))
+}
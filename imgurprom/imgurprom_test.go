@@ -0,0 +1,53 @@
+package imgurprom
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/koffeinsource/go-imgur"
+	"github.com/koffeinsource/go-imgur/imgurtest"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics(t *testing.T) {
+	reg := prometheus.NewPedanticRegistry()
+	metrics, err := New(reg)
+	require.NoError(t, err)
+
+	srv := imgurtest.NewServer()
+	defer srv.Close()
+	client, err := srv.NewClient(imgur.WithMetrics(metrics))
+	require.NoError(t, err)
+
+	data := []byte("GIF89a" + strings.Repeat("x", 100))
+	img, _, err := client.Upload(context.Background(), imgur.BytesSource(data))
+	require.NoError(t, err)
+	_, _, err = client.GetImageInfo(img.ID)
+	require.NoError(t, err)
+	_, _, err = client.GetImageInfo("missing")
+	require.Error(t, err)
+
+	require.Equal(t, 1.0, testutil.ToFloat64(metrics.requests.WithLabelValues("POST", "image", "200")))
+	require.Equal(t, 1.0, testutil.ToFloat64(metrics.requests.WithLabelValues("GET", "image/{id}", "200")))
+	require.Equal(t, 1.0, testutil.ToFloat64(metrics.requests.WithLabelValues("GET", "image/{id}", "404")))
+	require.Greater(t, testutil.ToFloat64(metrics.sentBytes.WithLabelValues("POST", "image")), float64(len(data)))
+	require.Equal(t, 2, testutil.CollectAndCount(metrics.duration))
+	remaining := srv.Credits()
+	require.Equal(t, float64(remaining.UserRemaining), testutil.ToFloat64(metrics.userRemaining))
+	require.Equal(t, float64(remaining.ClientRemaining), testutil.ToFloat64(metrics.clientRemaining))
+
+	lint, err := testutil.GatherAndLint(reg)
+	require.NoError(t, err)
+	require.Empty(t, lint)
+}
+
+func TestNewRegistersOnce(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	_, err := New(reg)
+	require.NoError(t, err)
+	_, err = New(reg)
+	require.Error(t, err)
+}
@@ -0,0 +1,45 @@
+// Package imgurredis stores the responses of an imgur client in Redis, so several
+// instances of a service share them and use fewer credits.
+//
+//	rdb := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+//	client, err := imgur.New(clientID, imgur.WithCache(imgurredis.New(rdb, "imgur:"), 10*time.Minute))
+//
+// The package is a module of its own, so the imgur package does not depend on a Redis client.
+package imgurredis
+
+import (
+	"context"
+	"time"
+
+	"github.com/koffeinsource/go-imgur"
+	"github.com/redis/go-redis/v9"
+)
+
+// Cache is an imgur.Cache storing the values in Redis
+type Cache struct {
+	rdb    redis.Cmdable
+	prefix string
+}
+
+var _ imgur.Cache = (*Cache)(nil)
+
+// New creates a Cache storing the values in rdb, e.g. a *redis.Client or *redis.ClusterClient.
+// prefix is put in front of all keys, so the cache can share a database.
+func New(rdb redis.Cmdable, prefix string) *Cache {
+	return &Cache{rdb: rdb, prefix: prefix}
+}
+
+// Get returns the value stored for key. Errors of Redis are treated as a miss.
+func (c *Cache) Get(ctx context.Context, key string) ([]byte, bool) {
+	value, err := c.rdb.Get(ctx, c.prefix+key).Bytes()
+	if err != nil {
+		return nil, false
+	}
+	return value, true
+}
+
+// Set stores value for key with ttl as expiration. Errors of Redis are ignored,
+// the request is sent to imgur again next time.
+func (c *Cache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) {
+	c.rdb.Set(ctx, c.prefix+key, value, ttl)
+}
@@ -0,0 +1,60 @@
+package imgurredis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/koffeinsource/go-imgur"
+	"github.com/koffeinsource/go-imgur/imgurtest"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr(), MaxRetries: -1})
+	defer rdb.Close()
+	ctx := context.Background()
+
+	cache := New(rdb, "imgur:")
+	_, ok := cache.Get(ctx, "a")
+	require.False(t, ok)
+	cache.Set(ctx, "a", []byte("1"), time.Minute)
+	value, ok := cache.Get(ctx, "a")
+	require.True(t, ok)
+	require.Equal(t, "1", string(value))
+	require.True(t, mr.Exists("imgur:a"))
+
+	mr.FastForward(2 * time.Minute)
+	_, ok = cache.Get(ctx, "a")
+	require.False(t, ok)
+
+	// a failing server is a miss
+	mr.Close()
+	cache.Set(ctx, "b", []byte("2"), time.Minute)
+	_, ok = cache.Get(ctx, "b")
+	require.False(t, ok)
+}
+
+func TestWithClient(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer rdb.Close()
+
+	srv := imgurtest.NewServer()
+	defer srv.Close()
+	client, err := srv.NewClient(imgur.WithCache(New(rdb, "imgur:"), time.Minute))
+	require.NoError(t, err)
+
+	img, _, err := client.Upload(context.Background(), imgur.BytesSource([]byte("GIF89a image")))
+	require.NoError(t, err)
+	requests := srv.Requests()
+	for i := 0; i < 3; i++ {
+		info, _, err := client.GetImageInfo(img.ID)
+		require.NoError(t, err)
+		require.Equal(t, img.ID, info.ID)
+	}
+	require.Equal(t, requests+1, srv.Requests())
+}
@@ -0,0 +1,207 @@
+package imgurtest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/koffeinsource/go-imgur"
+)
+
+// album is a created album, its info does not contain the images
+type album struct {
+	info   imgur.AlbumInfo
+	images []imgur.ImageID
+	owner  string // access token of the creator, empty for anonymous albums
+}
+
+// Album returns the stored album with the given ID, including its images
+func (s *Server) Album(id imgur.AlbumID) (imgur.AlbumInfo, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	a, ok := s.albums[id]
+	if !ok {
+		return imgur.AlbumInfo{}, false
+	}
+	return s.albumView(a, &request{owner: true}), true
+}
+
+func (s *Server) serveAlbum(w http.ResponseWriter, r *request) {
+	switch {
+	case len(r.path) == 1 && r.Method == http.MethodPost:
+		s.createAlbum(w, r)
+	case len(r.path) == 2 && r.Method == http.MethodGet:
+		a, ok := s.albums[imgur.AlbumID(r.path[1])]
+		if !ok {
+			writeError(w, r.Request, http.StatusNotFound, "Unable to find an album with the id, "+r.path[1])
+			return
+		}
+		writeData(w, s.albumView(a, r))
+	case len(r.path) == 3 && r.path[2] == "images" && r.Method == http.MethodGet:
+		a, ok := s.albums[imgur.AlbumID(r.path[1])]
+		if !ok {
+			writeError(w, r.Request, http.StatusNotFound, "Unable to find an album with the id, "+r.path[1])
+			return
+		}
+		writeData(w, s.albumView(a, r).Images)
+	case len(r.path) == 2 && (r.Method == http.MethodPut || r.Method == http.MethodPost):
+		s.updateAlbum(w, r)
+	case len(r.path) == 2 && r.Method == http.MethodDelete:
+		a, status := s.ownedAlbum(r, r.path[1])
+		if a == nil {
+			writeError(w, r.Request, status, "")
+			return
+		}
+		delete(s.albums, a.info.ID)
+		delete(s.albumHashes, a.info.Deletehash)
+		s.unshare(string(a.info.ID))
+		writeData(w, true)
+	case len(r.path) == 3 && (r.path[2] == "add" || r.path[2] == "remove_images") && r.Method == http.MethodPost:
+		a, status := s.ownedAlbum(r, r.path[1])
+		if a == nil {
+			writeError(w, r.Request, status, "")
+			return
+		}
+		ids, msg := s.formImages(r)
+		if msg != "" {
+			writeError(w, r.Request, http.StatusBadRequest, msg)
+			return
+		}
+		if r.path[2] == "add" {
+			a.images = append(removeImages(a.images, ids...), ids...)
+		} else {
+			a.images = removeImages(a.images, ids...)
+		}
+		writeData(w, true)
+	default:
+		writeError(w, r.Request, http.StatusNotFound, "Not found")
+	}
+}
+
+func (s *Server) createAlbum(w http.ResponseWriter, r *request) {
+	ids, msg := s.formImages(r)
+	if msg != "" {
+		writeError(w, r.Request, http.StatusBadRequest, msg)
+		return
+	}
+	a := &album{
+		info: imgur.AlbumInfo{
+			ID:         imgur.AlbumID(s.newID()),
+			DateTime:   imgur.UnixTime(time.Now().Unix()),
+			Privacy:    "hidden",
+			Layout:     "blog",
+			Deletehash: imgur.DeleteHash(randomID(15)),
+		},
+		images: ids,
+		owner:  r.token,
+	}
+	a.info.Link = s.URL + "/a/" + string(a.info.ID)
+	a.update(r)
+	s.albums[a.info.ID] = a
+	s.albumHashes[a.info.Deletehash] = a.info.ID
+	writeData(w, imgur.CreatedAlbum{ID: a.info.ID, Deletehash: a.info.Deletehash})
+}
+
+func (s *Server) updateAlbum(w http.ResponseWriter, r *request) {
+	a, status := s.ownedAlbum(r, r.path[1])
+	if a == nil {
+		writeError(w, r.Request, status, "")
+		return
+	}
+	ids, msg := s.formImages(r)
+	if msg != "" {
+		writeError(w, r.Request, http.StatusBadRequest, msg)
+		return
+	}
+	if len(ids) > 0 {
+		a.images = ids
+	}
+	a.update(r)
+	writeData(w, true)
+}
+
+// update sets the fields of a sent in the form of r
+func (a *album) update(r *request) {
+	fields := map[string]*string{
+		"title":       &a.info.Title,
+		"description": &a.info.Description,
+		"privacy":     &a.info.Privacy,
+		"layout":      &a.info.Layout,
+	}
+	for name, field := range fields {
+		if v, ok := r.Form[name]; ok {
+			*field = v[0]
+		}
+	}
+	if v, ok := r.Form["cover"]; ok {
+		a.info.Cover = imgur.ImageID(v[0])
+	}
+}
+
+// formImages returns the images sent in the ids[] and deletehashes[] fields of the form
+// of r. The IDs have to belong to the sender. If an image is invalid, it returns an error message.
+func (s *Server) formImages(r *request) ([]imgur.ImageID, string) {
+	var ids []imgur.ImageID
+	for _, id := range r.Form["ids[]"] {
+		img, ok := s.images[imgur.ImageID(id)]
+		if !ok || !r.owns(img.owner) {
+			return nil, "Invalid image ID " + id
+		}
+		ids = append(ids, img.info.ID)
+	}
+	for _, hash := range r.Form["deletehashes[]"] {
+		id, ok := s.imageHashes[imgur.DeleteHash(hash)]
+		if !ok {
+			return nil, "Invalid image deletehash " + hash
+		}
+		ids = append(ids, id)
+	}
+	return ids, ""
+}
+
+// albumView returns the info of a with its images as seen by the sender of r
+func (s *Server) albumView(a *album, r *request) imgur.AlbumInfo {
+	info := a.info
+	if !r.owns(a.owner) {
+		info.Deletehash = ""
+	}
+	info.Images = []imgur.ImageInfo{}
+	for _, id := range a.images {
+		info.Images = append(info.Images, s.images[id].view(r))
+	}
+	info.ImagesCount = len(info.Images)
+	if info.Cover == "" && len(a.images) > 0 {
+		info.Cover = a.images[0]
+	}
+	return info
+}
+
+// ownedAlbum finds the album ref, which is the deletehash or the ID of an album
+// of the user sending r. If there is none, it returns the status to send instead.
+func (s *Server) ownedAlbum(r *request, ref string) (*album, int) {
+	if id, ok := s.albumHashes[imgur.DeleteHash(ref)]; ok {
+		return s.albums[id], 0
+	}
+	a, ok := s.albums[imgur.AlbumID(ref)]
+	if !ok {
+		return nil, http.StatusNotFound
+	}
+	if !r.owns(a.owner) {
+		return nil, http.StatusForbidden
+	}
+	return a, 0
+}
+
+// removeImages returns ids without the images in remove
+func removeImages(ids []imgur.ImageID, remove ...imgur.ImageID) []imgur.ImageID {
+	kept := ids[:0:0]
+	for _, id := range ids {
+		found := false
+		for _, r := range remove {
+			found = found || id == r
+		}
+		if !found {
+			kept = append(kept, id)
+		}
+	}
+	return kept
+}
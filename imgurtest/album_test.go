@@ -0,0 +1,81 @@
+package imgurtest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/koffeinsource/go-imgur"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnonymousAlbum(t *testing.T) {
+	srv, client := newTestServer(t)
+	ctx := context.Background()
+
+	a, _, err := client.Upload(ctx, imgur.BytesSource(pngHeader))
+	require.NoError(t, err)
+	b, _, err := client.Upload(ctx, imgur.BytesSource(pngHeader))
+	require.NoError(t, err)
+
+	created, _, err := client.CreateAlbum(ctx, imgur.AlbumOptions{Title: "album", DeleteHashes: []imgur.DeleteHash{a.Deletehash}})
+	require.NoError(t, err)
+	require.NotEmpty(t, created.Deletehash)
+
+	_, err = client.AddImagesToAlbum(ctx, created.Deletehash, b.Deletehash)
+	require.NoError(t, err)
+	album, _, err := client.GetAlbumInfoWithContext(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, "album", album.Title)
+	require.Equal(t, a.ID, album.Cover)
+	require.Equal(t, 2, album.ImagesCount)
+	require.Equal(t, a.ID, album.Images[0].ID)
+	require.Equal(t, b.ID, album.Images[1].ID)
+	require.Empty(t, album.Deletehash)
+
+	_, err = client.UpdateAlbum(ctx, created.ID, imgur.AlbumOptions{Title: "changed"})
+	require.True(t, errors.Is(err, imgur.ErrUnauthorized))
+	_, err = client.UpdateAlbum(ctx, created.Deletehash, imgur.AlbumOptions{Title: "changed", DeleteHashes: []imgur.DeleteHash{b.Deletehash}})
+	require.NoError(t, err)
+	stored, ok := srv.Album(created.ID)
+	require.True(t, ok)
+	require.Equal(t, "changed", stored.Title)
+	require.Len(t, stored.Images, 1)
+	require.Equal(t, created.Deletehash, stored.Deletehash)
+
+	_, _, err = client.DeleteImage(ctx, b.Deletehash)
+	require.NoError(t, err)
+	stored, _ = srv.Album(created.ID)
+	require.Len(t, stored.Images, 0)
+
+	_, err = client.DeleteAlbum(ctx, created.Deletehash)
+	require.NoError(t, err)
+	_, _, err = client.GetAlbumInfoWithContext(ctx, created.ID)
+	require.True(t, errors.Is(err, imgur.ErrNotFound))
+}
+
+func TestAccountAlbum(t *testing.T) {
+	srv, client := newTestServer(t, imgur.WithAccessToken("token"))
+	ctx := context.Background()
+
+	img, _, err := client.Upload(ctx, imgur.BytesSource(pngHeader))
+	require.NoError(t, err)
+	created, _, err := client.CreateAlbum(ctx, imgur.AlbumOptions{ImageIDs: []imgur.ImageID{img.ID}})
+	require.NoError(t, err)
+
+	uploaded, _, err := client.Upload(ctx, imgur.BytesSource(pngHeader), imgur.WithAlbum(created.ID))
+	require.NoError(t, err)
+	stored, _ := srv.Album(created.ID)
+	require.Len(t, stored.Images, 2)
+
+	_, err = client.RemoveImagesFromAlbum(ctx, created.ID, img.ID)
+	require.NoError(t, err)
+	stored, _ = srv.Album(created.ID)
+	require.Len(t, stored.Images, 1)
+	require.Equal(t, uploaded.ID, stored.Images[0].ID)
+
+	anonymous, err := srv.NewClient()
+	require.NoError(t, err)
+	_, _, err = anonymous.CreateAlbum(ctx, imgur.AlbumOptions{ImageIDs: []imgur.ImageID{img.ID}})
+	require.Error(t, err)
+}
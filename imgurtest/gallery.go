@@ -0,0 +1,192 @@
+package imgurtest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/koffeinsource/go-imgur"
+)
+
+// galleryPost is an image or album shared to the gallery
+type galleryPost struct {
+	id       string
+	isAlbum  bool
+	title    string
+	topic    string
+	mature   bool
+	datetime imgur.UnixTime
+}
+
+func (s *Server) serveGallery(w http.ResponseWriter, r *request) {
+	switch {
+	case len(r.path) == 3 && (r.path[1] == "image" || r.path[1] == "album") && r.Method == http.MethodPost:
+		s.share(w, r)
+	case len(r.path) == 2 && r.Method == http.MethodDelete:
+		if r.token == "" {
+			writeError(w, r.Request, http.StatusUnauthorized, "Authentication required")
+			return
+		}
+		post := s.galleryPost(r.path[1])
+		if post == nil {
+			writeError(w, r.Request, http.StatusNotFound, "")
+			return
+		}
+		if s.postOwner(post) != r.token {
+			writeError(w, r.Request, http.StatusForbidden, "")
+			return
+		}
+		s.unshare(post.id)
+		writeData(w, true)
+	case len(r.path) == 3 && (r.path[1] == "image" || r.path[1] == "album") && r.Method == http.MethodGet:
+		post := s.galleryPost(r.path[2])
+		if post == nil || post.isAlbum != (r.path[1] == "album") {
+			writeError(w, r.Request, http.StatusNotFound, "Unable to find a gallery "+r.path[1]+" with the id, "+r.path[2])
+			return
+		}
+		writeData(w, s.galleryView(post, r))
+	case len(r.path) >= 3 && r.Method == http.MethodGet:
+		s.listGallery(w, r)
+	default:
+		writeError(w, r.Request, http.StatusNotFound, "Not found")
+	}
+}
+
+// share publishes the image or album with the form values of r, sharing a post again changes it
+func (s *Server) share(w http.ResponseWriter, r *request) {
+	if r.token == "" {
+		writeError(w, r.Request, http.StatusUnauthorized, "Authentication required")
+		return
+	}
+	post := &galleryPost{id: r.path[2], isAlbum: r.path[1] == "album", datetime: imgur.UnixTime(time.Now().Unix())}
+	if s.postOwner(post) != r.token {
+		writeError(w, r.Request, http.StatusNotFound, "")
+		return
+	}
+	post.title = strings.TrimSpace(r.FormValue("title"))
+	if post.title == "" {
+		writeError(w, r.Request, http.StatusBadRequest, "A title is required")
+		return
+	}
+	post.topic = r.FormValue("topic")
+	post.mature = r.FormValue("mature") == "1"
+
+	if post.isAlbum {
+		s.albums[imgur.AlbumID(post.id)].info.InGallery = true
+	} else {
+		img := s.images[imgur.ImageID(post.id)]
+		img.info.InGallery = true
+		img.info.Tags = nil
+		for _, tag := range strings.Split(r.FormValue("tags"), ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				img.info.Tags = append(img.info.Tags, imgur.Tag{Name: tag, DisplayName: tag})
+			}
+		}
+	}
+	if old := s.galleryPost(post.id); old != nil {
+		*old = *post
+	} else {
+		s.gallery = append([]*galleryPost{post}, s.gallery...)
+	}
+	writeData(w, true)
+}
+
+// listGallery sends all posts on the first page of any gallery section, newest first.
+// Mature posts are only listed if the mature parameter is true.
+func (s *Server) listGallery(w http.ResponseWriter, r *request) {
+	page, err := strconv.Atoi(r.path[len(r.path)-1])
+	if err != nil {
+		writeError(w, r.Request, http.StatusNotFound, "Not found")
+		return
+	}
+	mature := r.FormValue("mature") == "true"
+	items := []interface{}{}
+	for _, post := range s.gallery {
+		if page == 0 && (mature || !post.mature) {
+			items = append(items, s.galleryView(post, r))
+		}
+	}
+	writeData(w, items)
+}
+
+// galleryView returns the GalleryImageInfo or GalleryAlbumInfo of post
+func (s *Server) galleryView(post *galleryPost, r *request) interface{} {
+	if post.isAlbum {
+		a := s.albumView(s.albums[imgur.AlbumID(post.id)], r)
+		return imgur.GalleryAlbumInfo{
+			ID:          a.ID,
+			Title:       post.title,
+			Description: a.Description,
+			DateTime:    post.datetime,
+			Cover:       a.Cover,
+			AccountURL:  a.AccountURL,
+			Privacy:     a.Privacy,
+			Layout:      a.Layout,
+			Link:        a.Link,
+			IsAlbum:     true,
+			Nsfw:        post.mature,
+			Topic:       post.topic,
+			ImagesCount: a.ImagesCount,
+			Images:      a.Images,
+		}
+	}
+	img := s.images[imgur.ImageID(post.id)].view(r)
+	return imgur.GalleryImageInfo{
+		ID:          img.ID,
+		Title:       post.title,
+		Description: img.Description,
+		Datetime:    post.datetime,
+		MimeType:    img.MimeType,
+		Animated:    img.Animated,
+		Size:        img.Size,
+		Link:        img.Link,
+		Mp4:         img.Mp4,
+		Nsfw:        post.mature,
+		Topic:       post.topic,
+		HasSound:    img.HasSound,
+	}
+}
+
+// galleryPost returns the post of the image or album id, nil if it is not shared
+func (s *Server) galleryPost(id string) *galleryPost {
+	for _, post := range s.gallery {
+		if post.id == id {
+			return post
+		}
+	}
+	return nil
+}
+
+// postOwner returns the access token of the owner of the image or album of post,
+// empty if it does not exist
+func (s *Server) postOwner(post *galleryPost) string {
+	if post.isAlbum {
+		if a, ok := s.albums[imgur.AlbumID(post.id)]; ok {
+			return a.owner
+		}
+		return ""
+	}
+	if img, ok := s.images[imgur.ImageID(post.id)]; ok {
+		return img.owner
+	}
+	return ""
+}
+
+// unshare removes the post of the image or album id from the gallery
+func (s *Server) unshare(id string) {
+	for i, post := range s.gallery {
+		if post.id != id {
+			continue
+		}
+		s.gallery = append(s.gallery[:i:i], s.gallery[i+1:]...)
+		if a, ok := s.albums[imgur.AlbumID(id)]; ok {
+			a.info.InGallery = false
+		}
+		if img, ok := s.images[imgur.ImageID(id)]; ok {
+			img.info.InGallery = false
+			img.info.Tags = nil
+		}
+		return
+	}
+}
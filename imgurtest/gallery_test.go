@@ -0,0 +1,74 @@
+package imgurtest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/koffeinsource/go-imgur"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGallery(t *testing.T) {
+	srv, client := newTestServer(t, imgur.WithAccessToken("token"))
+	ctx := context.Background()
+
+	img, _, err := client.Upload(ctx, imgur.BytesSource(pngHeader))
+	require.NoError(t, err)
+	album, _, err := client.CreateAlbum(ctx, imgur.AlbumOptions{ImageIDs: []imgur.ImageID{img.ID}})
+	require.NoError(t, err)
+
+	_, err = client.ShareToGallery(ctx, img.ID, "image", "funny", false, []string{"cats"})
+	require.NoError(t, err)
+	_, err = client.ShareToGallery(ctx, album.ID, "album", "", true, nil)
+	require.NoError(t, err)
+
+	galleryImage, _, err := client.GetGalleryImageInfoWithContext(ctx, img.ID)
+	require.NoError(t, err)
+	require.Equal(t, "image", galleryImage.Title)
+	require.Equal(t, "funny", galleryImage.Topic)
+	stored, _ := srv.Image(img.ID)
+	require.True(t, stored.InGallery)
+	require.Equal(t, "cats", stored.Tags[0].Name)
+
+	galleryAlbum, _, err := client.GetGalleryAlbumInfoWithContext(ctx, album.ID)
+	require.NoError(t, err)
+	require.True(t, galleryAlbum.IsAlbum)
+	require.Len(t, galleryAlbum.Images, 1)
+
+	items, _, err := client.GetGallery(ctx, imgur.SectionHot, "", "", 0, false, false)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	require.Equal(t, img.ID, items[0].AsImage().ID)
+
+	items, _, err = client.GetGallery(ctx, imgur.SectionUser, "", "", 0, true, true)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	require.True(t, items[0].IsAlbum())
+
+	items, _, err = client.GetGallery(ctx, imgur.SectionHot, "", "", 1, false, true)
+	require.NoError(t, err)
+	require.Empty(t, items)
+
+	_, err = client.RemoveFromGallery(ctx, img.ID)
+	require.NoError(t, err)
+	_, _, err = client.GetGalleryImageInfoWithContext(ctx, img.ID)
+	require.True(t, errors.Is(err, imgur.ErrNotFound))
+	stored, _ = srv.Image(img.ID)
+	require.False(t, stored.InGallery)
+}
+
+func TestShareRequiresOwner(t *testing.T) {
+	srv, client := newTestServer(t)
+	ctx := context.Background()
+
+	img, _, err := client.Upload(ctx, imgur.BytesSource(pngHeader))
+	require.NoError(t, err)
+	other, err := srv.NewClient(imgur.WithAccessToken("other"))
+	require.NoError(t, err)
+
+	_, err = other.ShareToGallery(ctx, img.ID, "title", "", false, nil)
+	require.True(t, errors.Is(err, imgur.ErrNotFound))
+	_, err = other.ShareToGallery(ctx, imgur.ImageID("missing"), "title", "", false, nil)
+	require.True(t, errors.Is(err, imgur.ErrNotFound))
+}
@@ -0,0 +1,269 @@
+package imgurtest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/koffeinsource/go-imgur"
+)
+
+// image is an uploaded image or video
+type image struct {
+	info  imgur.ImageInfo
+	data  []byte
+	owner string // access token of the uploader, empty for anonymous uploads
+}
+
+// extensions of the files served for the MIME types of uploads
+var extensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+	"video/mp4":  ".mp4",
+	"video/webm": ".webm",
+}
+
+// Image returns the stored image with the given ID
+func (s *Server) Image(id imgur.ImageID) (imgur.ImageInfo, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	img, ok := s.images[id]
+	if !ok {
+		return imgur.ImageInfo{}, false
+	}
+	return img.info, true
+}
+
+// Images returns the number of stored images
+func (s *Server) Images() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.images)
+}
+
+func (s *Server) serveImage(w http.ResponseWriter, r *request) {
+	if len(r.path) == 1 {
+		if r.Method != http.MethodPost {
+			writeError(w, r.Request, http.StatusMethodNotAllowed, "Method not allowed")
+			return
+		}
+		s.upload(w, r)
+		return
+	}
+	if r.path[0] != "image" || len(r.path) != 2 {
+		writeError(w, r.Request, http.StatusNotFound, "Not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		img, ok := s.images[imgur.ImageID(r.path[1])]
+		if !ok {
+			writeError(w, r.Request, http.StatusNotFound, "Unable to find an image with the id, "+r.path[1])
+			return
+		}
+		writeData(w, img.view(r))
+	case http.MethodPost:
+		img, status := s.ownedImage(r, r.path[1])
+		if img == nil {
+			writeError(w, r.Request, status, "")
+			return
+		}
+		if v, ok := r.Form["title"]; ok {
+			img.info.Title = v[0]
+		}
+		if v, ok := r.Form["description"]; ok {
+			img.info.Description = v[0]
+		}
+		img.info.Edited = imgur.UnixTime(time.Now().Unix())
+		writeData(w, true)
+	case http.MethodDelete:
+		img, status := s.ownedImage(r, r.path[1])
+		if img == nil {
+			writeError(w, r.Request, status, "")
+			return
+		}
+		s.deleteImage(img.info.ID)
+		writeData(w, true)
+	default:
+		writeError(w, r.Request, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// upload stores the image or video of an upload request
+func (s *Server) upload(w http.ResponseWriter, r *request) {
+	field, video := "image", false
+	if _, ok := formContent(r.Request, "video"); ok {
+		field, video = "video", true
+	}
+	data, ok := formContent(r.Request, field)
+	if !ok {
+		writeError(w, r.Request, http.StatusBadRequest, "No image data was sent to the upload api")
+		return
+	}
+
+	var link string
+	switch strings.ToLower(r.FormValue("type")) {
+	case "base64":
+		decoded, err := base64.StdEncoding.DecodeString(string(data))
+		if err != nil {
+			writeError(w, r.Request, http.StatusBadRequest, "Invalid base64 data")
+			return
+		}
+		data = decoded
+	case "url":
+		// the file is not downloaded, the server has to work without network access
+		link, data = string(data), nil
+	}
+
+	var addTo *album
+	if ref := r.FormValue("album"); ref != "" {
+		var status int
+		if addTo, status = s.ownedAlbum(r, ref); addTo == nil {
+			writeError(w, r.Request, status, "Invalid album")
+			return
+		}
+	}
+
+	img := &image{data: data, owner: r.token}
+	mimeType := detectType(data, link, video)
+	img.info = imgur.ImageInfo{
+		ID:          imgur.ImageID(s.newID()),
+		Title:       r.FormValue("title"),
+		Description: r.FormValue("description"),
+		Datetime:    imgur.UnixTime(time.Now().Unix()),
+		MimeType:    mimeType,
+		Animated:    video || mimeType == "image/gif",
+		Size:        len(data),
+		Deletehash:  imgur.DeleteHash(randomID(15)),
+		Name:        r.FormValue("name"),
+	}
+	if link != "" && img.info.Name == "" {
+		img.info.Name = path.Base(link)
+	}
+	img.info.Link = s.URL + "/" + string(img.info.ID) + extensions[mimeType]
+	if video {
+		img.info.Mp4 = img.info.Link
+		img.info.Processing = &imgur.Processing{Status: "completed"}
+	}
+
+	s.images[img.info.ID] = img
+	s.imageHashes[img.info.Deletehash] = img.info.ID
+	if addTo != nil {
+		addTo.images = append(addTo.images, img.info.ID)
+	}
+	writeData(w, img.info)
+}
+
+// formContent returns the file or value sent in field of the form of r
+func formContent(r *http.Request, field string) ([]byte, bool) {
+	if r.MultipartForm != nil && len(r.MultipartForm.File[field]) > 0 {
+		f, err := r.MultipartForm.File[field][0].Open()
+		if err != nil {
+			return nil, false
+		}
+		defer f.Close()
+		data, err := io.ReadAll(f)
+		return data, err == nil
+	}
+	if v, ok := r.Form[field]; ok {
+		return []byte(v[0]), true
+	}
+	return nil, false
+}
+
+// detectType returns the MIME type of an upload. Data that is not recognized is
+// stored as JPEG or MP4, so tests can upload placeholder bytes.
+func detectType(data []byte, link string, video bool) string {
+	mimeType := http.DetectContentType(data)
+	if link != "" {
+		mimeType = ""
+		for t, ext := range extensions {
+			if strings.EqualFold(path.Ext(link), ext) {
+				mimeType = t
+			}
+		}
+	}
+	switch {
+	case video && !strings.HasPrefix(mimeType, "video/"):
+		return "video/mp4"
+	case !video && extensions[mimeType] == "":
+		return "image/jpeg"
+	}
+	return mimeType
+}
+
+// view returns the info of img as seen by the sender of r, only the owner gets the deletehash
+func (img *image) view(r *request) imgur.ImageInfo {
+	info := img.info
+	if !r.owns(img.owner) {
+		info.Deletehash = ""
+		info.Name = ""
+	}
+	return info
+}
+
+// ownedImage finds the image ref, which is the deletehash or the ID of an image
+// of the user sending r. If there is none, it returns the status to send instead.
+func (s *Server) ownedImage(r *request, ref string) (*image, int) {
+	if id, ok := s.imageHashes[imgur.DeleteHash(ref)]; ok {
+		return s.images[id], 0
+	}
+	img, ok := s.images[imgur.ImageID(ref)]
+	if !ok {
+		return nil, http.StatusNotFound
+	}
+	if !r.owns(img.owner) {
+		return nil, http.StatusForbidden
+	}
+	return img, 0
+}
+
+// deleteImage removes the image id from the storage, its albums and the gallery
+func (s *Server) deleteImage(id imgur.ImageID) {
+	img, ok := s.images[id]
+	if !ok {
+		return
+	}
+	delete(s.images, id)
+	delete(s.imageHashes, img.info.Deletehash)
+	for _, a := range s.albums {
+		a.images = removeImages(a.images, id)
+		if a.info.Cover == id {
+			a.info.Cover = ""
+		}
+	}
+	s.unshare(string(id))
+}
+
+// serveFile serves the direct links of the uploaded files, like imgur serves them on i.imgur.com
+func (s *Server) serveFile(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimPrefix(r.URL.Path, "/")
+	s.mu.Lock()
+	img, ok := s.images[imgur.ImageID(strings.TrimSuffix(name, path.Ext(name)))]
+	s.mu.Unlock()
+	if !ok || r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", img.info.MimeType)
+	http.ServeContent(w, r, name, img.info.CreatedAt(), bytes.NewReader(img.data))
+}
+
+// newID returns an ID that is not used by an image or album
+func (s *Server) newID() string {
+	for {
+		id := randomID(7)
+		_, usedByImage := s.images[imgur.ImageID(id)]
+		_, usedByAlbum := s.albums[imgur.AlbumID(id)]
+		if !usedByImage && !usedByAlbum {
+			return id
+		}
+	}
+}
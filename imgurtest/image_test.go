@@ -0,0 +1,96 @@
+package imgurtest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/koffeinsource/go-imgur"
+	"github.com/stretchr/testify/require"
+)
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n0000")
+
+func TestUploadAndGetImage(t *testing.T) {
+	srv, client := newTestServer(t)
+	ctx := context.Background()
+
+	img, status, err := client.Upload(ctx, imgur.BytesSource(pngHeader), imgur.WithTitle("title"), imgur.WithName("cat.png"))
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Len(t, img.ID, 7)
+	require.NotEmpty(t, img.Deletehash)
+	require.Equal(t, "title", img.Title)
+	require.Equal(t, "cat.png", img.Name)
+	require.Equal(t, "image/png", img.MimeType)
+	require.Equal(t, len(pngHeader), img.Size)
+	require.Equal(t, srv.URL+"/"+string(img.ID)+".png", img.Link)
+
+	stored, ok := srv.Image(img.ID)
+	require.True(t, ok)
+	require.Equal(t, img.Deletehash, stored.Deletehash)
+
+	got, _, err := client.GetImageInfoWithContext(ctx, img.ID)
+	require.NoError(t, err)
+	require.Equal(t, img.ID, got.ID)
+	require.Equal(t, "title", got.Title)
+	require.Empty(t, got.Deletehash)
+
+	r, _, err := client.DownloadImage(ctx, string(img.ID))
+	require.NoError(t, err)
+	data, err := io.ReadAll(r)
+	r.Close()
+	require.NoError(t, err)
+	require.Equal(t, pngHeader, data)
+}
+
+func TestUploadVideoAndURL(t *testing.T) {
+	_, client := newTestServer(t)
+	ctx := context.Background()
+
+	video, _, err := client.UploadVideo(ctx, strings.NewReader("video"), 5)
+	require.NoError(t, err)
+	require.Equal(t, "video/mp4", video.MimeType)
+	require.Equal(t, "completed", video.Processing.Status)
+
+	img, _, err := client.UploadImageFromURL(ctx, "https://example.com/cat.gif")
+	require.NoError(t, err)
+	require.Equal(t, "image/gif", img.MimeType)
+	require.Equal(t, "cat.gif", img.Name)
+}
+
+func TestUpdateAndDeleteImage(t *testing.T) {
+	srv, anonymous := newTestServer(t)
+	user, err := srv.NewClient(imgur.WithAccessToken("token"))
+	require.NoError(t, err)
+	other, err := srv.NewClient(imgur.WithAccessToken("other"))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	anon, _, err := anonymous.Upload(ctx, imgur.BytesSource(pngHeader))
+	require.NoError(t, err)
+	owned, _, err := user.Upload(ctx, imgur.BytesSource(pngHeader))
+	require.NoError(t, err)
+
+	_, err = anonymous.UpdateImage(ctx, anon.ID, "title", "")
+	require.True(t, errors.Is(err, imgur.ErrUnauthorized))
+	_, err = anonymous.UpdateImage(ctx, anon.Deletehash, "title", "description")
+	require.NoError(t, err)
+	info, _ := srv.Image(anon.ID)
+	require.Equal(t, "title", info.Title)
+	require.Equal(t, "description", info.Description)
+	require.False(t, info.EditedAt().IsZero())
+
+	_, _, err = other.DeleteImage(ctx, owned.ID)
+	require.True(t, errors.Is(err, imgur.ErrUnauthorized))
+	_, _, err = user.DeleteImage(ctx, owned.ID)
+	require.NoError(t, err)
+	_, _, err = anonymous.DeleteImage(ctx, anon.Deletehash)
+	require.NoError(t, err)
+	require.Equal(t, 0, srv.Images())
+
+	_, _, err = user.GetImageInfoWithContext(ctx, owned.ID)
+	require.True(t, errors.Is(err, imgur.ErrNotFound))
+}
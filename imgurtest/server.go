@@ -0,0 +1,302 @@
+// Package imgurtest provides a fake imgur API for tests of applications using the imgur client.
+//
+// The Server answers the image, upload, album, gallery and credits endpoints from memory,
+// sends the rate limit headers of imgur and can inject errors, so tests run without
+// network access:
+//
+//	srv := imgurtest.NewServer()
+//	defer srv.Close()
+//	client, _ := srv.NewClient()
+//	img, _, err := client.Upload(ctx, imgur.BytesSource(data))
+package imgurtest
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/koffeinsource/go-imgur"
+)
+
+// Default credits of a new Server, the daily limits imgur grants an application
+const (
+	DefaultUserLimit   = 2000
+	DefaultClientLimit = 12500
+)
+
+// uploadCost are the credits imgur charges for an upload, all other requests cost 1
+const uploadCost = 10
+
+// Fault makes the Server fail matching requests instead of answering them
+type Fault struct {
+	Method  string // HTTP method of the failing requests, any method if empty
+	Path    string // Prefix of the path of the failing requests like "/3/image", any path if empty
+	Status  int    // HTTP status of the failure
+	Message string // Error message sent in data.error
+	Times   int    // Number of requests that fail, if 0 the fault stays until ClearFaults
+}
+
+func (f *Fault) matches(r *http.Request) bool {
+	return (f.Method == "" || strings.EqualFold(f.Method, r.Method)) && strings.HasPrefix(r.URL.Path, f.Path)
+}
+
+// Server is a fake imgur API backed by memory. It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	images      map[imgur.ImageID]*image
+	albums      map[imgur.AlbumID]*album
+	gallery     []*galleryPost // newest post first
+	credits     imgur.RateLimit
+	faults      []*Fault
+	requests    int
+	imageHashes map[imgur.DeleteHash]imgur.ImageID
+	albumHashes map[imgur.DeleteHash]imgur.AlbumID
+}
+
+// NewServer starts a Server with empty storage and the default credits.
+// The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		images:      map[imgur.ImageID]*image{},
+		albums:      map[imgur.AlbumID]*album{},
+		imageHashes: map[imgur.DeleteHash]imgur.ImageID{},
+		albumHashes: map[imgur.DeleteHash]imgur.AlbumID{},
+		credits: imgur.RateLimit{
+			UserLimit:       DefaultUserLimit,
+			UserRemaining:   DefaultUserLimit,
+			UserReset:       time.Now().Add(time.Hour).Truncate(time.Second),
+			ClientLimit:     DefaultClientLimit,
+			ClientRemaining: DefaultClientLimit,
+		},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// NewClient creates an imgur client that sends all requests to the Server.
+// opts are applied after the options pointing the client to the Server.
+func (s *Server) NewClient(opts ...imgur.ClientOption) (*imgur.Client, error) {
+	opts = append([]imgur.ClientOption{
+		imgur.WithHTTPClient(s.Client()),
+		imgur.WithBaseURL(s.URL + "/3/"),
+	}, opts...)
+	return imgur.New("imgurtest", opts...)
+}
+
+// Inject adds a fault, faults are matched in the order they were added
+func (s *Server) Inject(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes all injected faults
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// SetCredits replaces the remaining credits. Requests are rejected with 429 once
+// the user or client credits are used up.
+func (s *Server) SetCredits(rl imgur.RateLimit) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.credits = rl
+}
+
+// Credits returns the remaining credits
+func (s *Server) Credits() imgur.RateLimit {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.credits
+}
+
+// Requests returns the number of API requests the Server received, including failed ones
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.URL.Path, "/3/") {
+		s.serveFile(w, r)
+		return
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		r.ParseMultipartForm(32 << 20)
+	} else {
+		r.ParseForm()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+
+	if f := s.fault(r); f != nil {
+		s.writeRateLimits(w)
+		writeError(w, r, f.Status, f.Message)
+		return
+	}
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Client-ID ") && !strings.HasPrefix(auth, "Bearer ") {
+		s.writeRateLimits(w)
+		writeError(w, r, http.StatusUnauthorized, "Authentication required")
+		return
+	}
+	charged := s.charge(r)
+	s.writeRateLimits(w)
+	if !charged {
+		writeError(w, r, http.StatusTooManyRequests, "Too Many Requests")
+		return
+	}
+
+	req := &request{Request: r, path: strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/3/"), "/"), "/")}
+	if strings.HasPrefix(auth, "Bearer ") {
+		req.token = strings.TrimPrefix(auth, "Bearer ")
+	}
+	switch req.path[0] {
+	case "image", "upload":
+		s.serveImage(w, req)
+	case "album":
+		s.serveAlbum(w, req)
+	case "gallery":
+		s.serveGallery(w, req)
+	case "credits":
+		s.serveCredits(w, req)
+	default:
+		writeError(w, r, http.StatusNotFound, "Not found")
+	}
+}
+
+// request is an API request, path is split into segments without the "/3/" prefix
+type request struct {
+	*http.Request
+	path  []string
+	token string // access token of an authenticated user, empty for anonymous requests
+	owner bool   // set for the accessors of Server, which see everything like the owner
+}
+
+// owns reports whether the sender of r is the user with the access token owner
+func (r *request) owns(owner string) bool {
+	return r.owner || r.token != "" && r.token == owner
+}
+
+// fault returns the first fault matching r and uses it up
+func (s *Server) fault(r *http.Request) *Fault {
+	for i, f := range s.faults {
+		if !f.matches(r) {
+			continue
+		}
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+		return f
+	}
+	return nil
+}
+
+// charge takes the credits of r, it reports false if there are not enough left
+func (s *Server) charge(r *http.Request) bool {
+	if r.URL.Path == "/3/credits" {
+		return true
+	}
+	cost := int64(1)
+	if r.Method == http.MethodPost && (r.URL.Path == "/3/image" || r.URL.Path == "/3/upload") {
+		cost = uploadCost
+	}
+	if s.credits.UserRemaining < cost || s.credits.ClientRemaining < cost {
+		return false
+	}
+	s.credits.UserRemaining -= cost
+	s.credits.ClientRemaining -= cost
+	return true
+}
+
+// writeRateLimits sets the rate limit headers, it has to be called before the body is written
+func (s *Server) writeRateLimits(w http.ResponseWriter) {
+	h := w.Header()
+	h.Set("X-RateLimit-UserLimit", strconv.FormatInt(s.credits.UserLimit, 10))
+	h.Set("X-RateLimit-UserRemaining", strconv.FormatInt(s.credits.UserRemaining, 10))
+	h.Set("X-RateLimit-UserReset", strconv.FormatInt(s.credits.UserReset.Unix(), 10))
+	h.Set("X-RateLimit-ClientLimit", strconv.FormatInt(s.credits.ClientLimit, 10))
+	h.Set("X-RateLimit-ClientRemaining", strconv.FormatInt(s.credits.ClientRemaining, 10))
+}
+
+func (s *Server) serveCredits(w http.ResponseWriter, r *request) {
+	if r.Method != http.MethodGet {
+		writeError(w, r.Request, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+	writeData(w, map[string]int64{
+		"UserLimit":       s.credits.UserLimit,
+		"UserRemaining":   s.credits.UserRemaining,
+		"UserReset":       s.credits.UserReset.Unix(),
+		"ClientLimit":     s.credits.ClientLimit,
+		"ClientRemaining": s.credits.ClientRemaining,
+	})
+}
+
+// writeData sends data in the envelope of a successful imgur response
+func writeData(w http.ResponseWriter, data interface{}) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data":    data,
+		"success": true,
+		"status":  http.StatusOK,
+	})
+}
+
+// writeError sends an error in the envelope of a failed imgur response
+func writeError(w http.ResponseWriter, r *http.Request, status int, message string) {
+	if message == "" {
+		message = http.StatusText(status)
+	}
+	writeJSON(w, status, map[string]interface{}{
+		"data": map[string]string{
+			"error":   message,
+			"request": r.URL.Path,
+			"method":  r.Method,
+		},
+		"success": false,
+		"status":  status,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+const idAlphabet = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// randomID returns a random alphanumeric string of length n, like the IDs of imgur
+func randomID(n int) string {
+	b := make([]byte, n)
+	max := big.NewInt(int64(len(idAlphabet)))
+	for i := range b {
+		k, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			panic(err)
+		}
+		b[i] = idAlphabet[k.Int64()]
+	}
+	return string(b)
+}
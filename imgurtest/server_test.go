@@ -0,0 +1,93 @@
+package imgurtest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/koffeinsource/go-imgur"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T, opts ...imgur.ClientOption) (*Server, *imgur.Client) {
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+	client, err := srv.NewClient(opts...)
+	require.NoError(t, err)
+	return srv, client
+}
+
+func TestCredits(t *testing.T) {
+	srv, client := newTestServer(t)
+
+	_, _, err := client.Upload(context.Background(), imgur.BytesSource([]byte("image")))
+	require.NoError(t, err)
+
+	credits, status, err := client.GetCredits(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Equal(t, int64(DefaultClientLimit), credits.ClientLimit)
+	require.Equal(t, int64(DefaultClientLimit-uploadCost), credits.ClientRemaining)
+	require.Equal(t, int64(DefaultUserLimit-uploadCost), credits.UserRemaining)
+	require.Equal(t, srv.Credits().UserReset, credits.UserReset)
+
+	rl := client.LastRateLimit()
+	require.NotNil(t, rl)
+	require.Equal(t, int64(DefaultUserLimit-uploadCost), rl.UserRemaining)
+}
+
+func TestCreditsExhausted(t *testing.T) {
+	srv, client := newTestServer(t)
+	srv.SetCredits(imgur.RateLimit{UserLimit: 10, UserRemaining: 1, ClientLimit: 10, ClientRemaining: 10, UserReset: time.Now()})
+
+	_, _, err := client.GetImageInfoWithContext(context.Background(), "missing")
+	require.True(t, errors.Is(err, imgur.ErrNotFound))
+	require.Equal(t, int64(0), srv.Credits().UserRemaining)
+
+	_, _, err = client.GetImageInfoWithContext(context.Background(), "missing")
+	require.True(t, errors.Is(err, imgur.ErrRateLimited))
+}
+
+func TestFaults(t *testing.T) {
+	srv, client := newTestServer(t, imgur.WithRetryPolicy(imgur.NoRetry))
+	srv.Inject(Fault{Method: "POST", Path: "/3/image", Status: 500, Message: "Internal error", Times: 2})
+
+	for i := 0; i < 2; i++ {
+		_, status, err := client.Upload(context.Background(), imgur.BytesSource([]byte("image")))
+		require.Error(t, err)
+		require.Equal(t, 500, status)
+		require.Contains(t, err.Error(), "Internal error")
+	}
+	_, _, err := client.Upload(context.Background(), imgur.BytesSource([]byte("image")))
+	require.NoError(t, err)
+	require.Equal(t, 3, srv.Requests())
+
+	srv.Inject(Fault{Status: 503})
+	_, _, err = client.GetCredits(context.Background())
+	require.Error(t, err)
+	srv.ClearFaults()
+	_, _, err = client.GetCredits(context.Background())
+	require.NoError(t, err)
+}
+
+func TestFaultRetried(t *testing.T) {
+	srv, client := newTestServer(t, imgur.WithRetryPolicy(imgur.RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}))
+	srv.Inject(Fault{Path: "/3/credits", Status: 503, Times: 1})
+
+	_, _, err := client.GetCredits(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, srv.Requests())
+}
+
+func TestUnauthenticated(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	res, err := http.Get(srv.URL + "/3/credits")
+	require.NoError(t, err)
+	res.Body.Close()
+	require.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	require.Equal(t, "12500", res.Header.Get("X-RateLimit-ClientRemaining"))
+}
@@ -0,0 +1,294 @@
+// Package vcr records the responses of imgur to a cassette file and replays them later,
+// so tests run without network access and without using up the credits of the client.
+//
+// Record once with real credentials, then commit the cassette and replay it in CI:
+//
+//	rec, err := vcr.New("test_data/image.json", vcr.ModeFromEnv())
+//	defer rec.Save()
+//	client, err := imgur.New(clientID, imgur.WithHTTPClient(rec.Client()))
+//
+// Cassettes are sanitized: request headers are not recorded, only a few response headers
+// are kept and OAuth tokens, secrets and the strings in Recorder.Secrets are replaced by
+// Redacted. The package does not depend on the imgur package, so its own tests can use it.
+package vcr
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// RecordEnv is the environment variable that makes ModeFromEnv return ModeRecord
+const RecordEnv = "IMGUR_RECORD"
+
+// Redacted replaces secrets in recorded cassettes
+const Redacted = "REDACTED"
+
+// ErrNotRecorded is returned in ModeReplay for requests that are missing in the cassette
+var ErrNotRecorded = errors.New("request not recorded")
+
+// Mode tells whether a Recorder sends requests or answers them from the cassette
+type Mode int
+
+// Modes of a Recorder
+const (
+	ModeReplay Mode = iota // Answer all requests from the cassette, requests that were not recorded fail
+	ModeRecord             // Send all requests and record the responses, the cassette is replaced by Save
+)
+
+// ModeFromEnv returns ModeRecord if the RecordEnv environment variable is set and ModeReplay otherwise
+func ModeFromEnv() Mode {
+	if os.Getenv(RecordEnv) != "" {
+		return ModeRecord
+	}
+	return ModeReplay
+}
+
+// secretFields are redacted in form encoded request bodies and JSON response bodies
+var secretFields = []string{"access_token", "refresh_token", "client_secret", "password"}
+
+// recordedHeaders are the response headers kept in cassettes, matched by prefix
+var recordedHeaders = []string{"Content-Type", "Content-Range", "Accept-Ranges", "Location", "Retry-After", "X-Ratelimit-", "X-Post-Rate-Limit-"}
+
+// Interaction is a recorded request and its response
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest identifies a request. The host is not recorded, so requests
+// sent through the RapidAPI endpoint match the same cassette.
+type RecordedRequest struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`           // Path and query of the URL
+	Body   string `json:"body,omitempty"` // The sanitized body of form encoded requests
+}
+
+// RecordedResponse is a sanitized response
+type RecordedResponse struct {
+	Status int                 `json:"status"`
+	Header map[string][]string `json:"header,omitempty"`
+	Body   string              `json:"body"`
+	Base64 bool                `json:"base64,omitempty"` // If Body is base64 encoded binary data
+}
+
+// cassette is the content of a cassette file
+type cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Recorder is an http.RoundTripper that records or replays the responses of imgur
+type Recorder struct {
+	// Transport sends the requests in ModeRecord, http.DefaultTransport if nil
+	Transport http.RoundTripper
+	// Secrets are replaced by Redacted in recorded interactions, e.g. the client ID
+	Secrets []string
+
+	mode   Mode
+	name   string
+	mu     sync.Mutex
+	tape   cassette
+	played map[*Interaction]bool
+}
+
+// New creates a Recorder for the cassette file name. In ModeReplay the cassette has to exist.
+func New(name string, mode Mode) (*Recorder, error) {
+	r := &Recorder{mode: mode, name: name, played: map[*Interaction]bool{}}
+	if mode == ModeRecord {
+		return r, nil
+	}
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("Could not read cassette %v - %w", name, err)
+	}
+	if err := json.Unmarshal(data, &r.tape); err != nil {
+		return nil, fmt.Errorf("Problem decoding cassette %v - %w", name, err)
+	}
+	return r, nil
+}
+
+// Mode returns the mode of r
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Client returns an http.Client sending all requests through r
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip answers req from the cassette or sends and records it
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorded, err := r.recordRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	res, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("Problem reading the body for %v - %w", req.URL, err)
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tape.Interactions = append(r.tape.Interactions, &Interaction{
+		Request:  recorded,
+		Response: r.recordResponse(res, body),
+	})
+	return res, nil
+}
+
+// Save writes the recorded interactions to the cassette, it does nothing in ModeReplay
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+	// the bodies are mostly JSON, they stay readable without HTML escaping
+	var data bytes.Buffer
+	enc := json.NewEncoder(&data)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "\t")
+	r.mu.Lock()
+	err := enc.Encode(&r.tape)
+	r.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("Problem encoding cassette %v - %w", r.name, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(r.name), 0o755); err != nil {
+		return fmt.Errorf("Problem writing cassette %v - %w", r.name, err)
+	}
+	if err := os.WriteFile(r.name, data.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("Problem writing cassette %v - %w", r.name, err)
+	}
+	return nil
+}
+
+// replay returns the first response recorded for req that was not played yet
+func (r *Recorder) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, in := range r.tape.Interactions {
+		if r.played[in] || in.Request != recorded {
+			continue
+		}
+		r.played[in] = true
+		body := []byte(in.Response.Body)
+		if in.Response.Base64 {
+			var err error
+			if body, err = base64.StdEncoding.DecodeString(in.Response.Body); err != nil {
+				return nil, fmt.Errorf("Problem decoding the body recorded for %v %v - %w", recorded.Method, recorded.Path, err)
+			}
+		}
+		header := http.Header{}
+		for k, v := range in.Response.Header {
+			header[k] = append([]string(nil), v...)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.Status, http.StatusText(in.Response.Status)),
+			StatusCode:    in.Response.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("No recorded response for %v %v in %v - %w", recorded.Method, recorded.Path, r.name, ErrNotRecorded)
+}
+
+// recordRequest returns the sanitized form of req. The body of req is replaced,
+// so it can still be sent.
+func (r *Recorder) recordRequest(req *http.Request) (RecordedRequest, error) {
+	recorded := RecordedRequest{Method: req.Method, Path: req.URL.EscapedPath()}
+	if req.URL.RawQuery != "" {
+		recorded.Path += "?" + r.redactForm(req.URL.RawQuery)
+	}
+	recorded.Path = r.redact(recorded.Path)
+	if req.Body == nil || !strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		return recorded, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return recorded, fmt.Errorf("Problem reading the body of %v - %w", req.URL, err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	recorded.Body = r.redact(r.redactForm(string(body)))
+	return recorded, nil
+}
+
+func (r *Recorder) recordResponse(res *http.Response, body []byte) RecordedResponse {
+	recorded := RecordedResponse{Status: res.StatusCode, Header: map[string][]string{}}
+	for k, v := range res.Header {
+		for _, prefix := range recordedHeaders {
+			if strings.HasPrefix(strings.ToLower(k), strings.ToLower(prefix)) {
+				recorded.Header[k] = v
+				break
+			}
+		}
+	}
+	if !utf8.Valid(body) {
+		recorded.Body = base64.StdEncoding.EncodeToString(body)
+		recorded.Base64 = true
+		return recorded
+	}
+	recorded.Body = r.redact(redactJSON(string(body)))
+	return recorded
+}
+
+// redact replaces the Secrets of r in s
+func (r *Recorder) redact(s string) string {
+	for _, secret := range r.Secrets {
+		if secret != "" {
+			s = strings.ReplaceAll(s, secret, Redacted)
+		}
+	}
+	return s
+}
+
+// redactForm replaces the secret fields of the form encoded values in s
+func (r *Recorder) redactForm(s string) string {
+	values, err := url.ParseQuery(s)
+	if err != nil {
+		return s
+	}
+	for _, field := range secretFields {
+		if _, ok := values[field]; ok {
+			values.Set(field, Redacted)
+		}
+	}
+	return values.Encode()
+}
+
+var secretJSON = regexp.MustCompile(`"(` + strings.Join(secretFields, "|") + `)"(\s*):(\s*)"[^"]*"`)
+
+// redactJSON replaces the string values of the secret fields in the JSON s
+func redactJSON(s string) string {
+	return secretJSON.ReplaceAllString(s, `"$1"$2:$3"`+Redacted+`"`)
+}
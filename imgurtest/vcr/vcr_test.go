@@ -0,0 +1,122 @@
+package vcr
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-ClientRemaining", "12000")
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/oauth2/token":
+			require.Equal(t, "secret", r.FormValue("client_secret"))
+			io.WriteString(w, `{"access_token": "at", "refresh_token":"rt", "account_username":"user"}`)
+		case "/i/cat.jpg":
+			w.Header().Set("Content-Type", "image/jpeg")
+			w.Write([]byte{0xff, 0xd8, 0xff, 0x00})
+		default:
+			require.Equal(t, "Client-ID clientid123", r.Header.Get("Authorization"))
+			io.WriteString(w, `{"data":{"id":"`+r.URL.Query().Get("n")+`","error":"client clientid123 unknown"},"status":200}`)
+		}
+	}))
+	defer server.Close()
+
+	name := filepath.Join(t.TempDir(), "cassettes", "test.json")
+	rec, err := New(name, ModeRecord)
+	require.NoError(t, err)
+	rec.Secrets = []string{"clientid123"}
+	client := rec.Client()
+
+	get := func(path string) string {
+		req, err := http.NewRequest("GET", server.URL+path, nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Client-ID clientid123")
+		res, err := client.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		return string(body)
+	}
+	token := func() string {
+		res, err := client.PostForm(server.URL+"/oauth2/token", url.Values{"client_secret": {"secret"}, "grant_type": {"refresh_token"}})
+		require.NoError(t, err)
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		return string(body)
+	}
+
+	// the recorded responses are returned unchanged
+	require.Contains(t, get("/3/image?n=1"), "clientid123")
+	require.Contains(t, get("/3/image?n=2"), `"id":"2"`)
+	require.Contains(t, token(), `"at"`)
+	require.Equal(t, "\xff\xd8\xff\x00", get("/i/cat.jpg"))
+	require.NoError(t, rec.Save())
+
+	data, err := os.ReadFile(name)
+	require.NoError(t, err)
+	for _, secret := range []string{"clientid123", `"at"`, `"rt"`, "=secret"} {
+		require.NotContains(t, string(data), secret)
+	}
+	require.Contains(t, string(data), "account_username")
+
+	rec, err = New(name, ModeReplay)
+	require.NoError(t, err)
+	client = rec.Client()
+	server.Close()
+
+	require.Equal(t, `{"data":{"id":"2","error":"client REDACTED unknown"},"status":200}`, get("/3/image?n=2"))
+	require.Contains(t, get("/3/image?n=1"), `"id":"1"`)
+	require.Equal(t, `{"access_token": "REDACTED", "refresh_token":"REDACTED", "account_username":"user"}`, token())
+	require.Equal(t, "\xff\xd8\xff\x00", get("/i/cat.jpg"))
+
+	res, err := http.NewRequest("GET", server.URL+"/3/image?n=1", nil)
+	require.NoError(t, err)
+	_, err = client.Do(res)
+	require.True(t, errors.Is(err, ErrNotRecorded))
+}
+
+func TestReplayHeaders(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "test.json")
+	require.NoError(t, os.WriteFile(name, []byte(`{"interactions":[{"request":{"method":"GET","path":"/3/credits"},"response":{"status":429,"header":{"X-Ratelimit-Userremaining":["0"]},"body":"{}"}}]}`), 0o644))
+
+	rec, err := New(name, ModeReplay)
+	require.NoError(t, err)
+	require.Equal(t, ModeReplay, rec.Mode())
+	res, err := rec.Client().Get("https://api.imgur.com/3/credits")
+	require.NoError(t, err)
+	res.Body.Close()
+	require.Equal(t, 429, res.StatusCode)
+	require.Equal(t, "0", res.Header.Get("X-RateLimit-UserRemaining"))
+	require.Empty(t, res.Header.Get("Set-Cookie"))
+}
+
+func TestNewMissingCassette(t *testing.T) {
+	_, err := New(filepath.Join(t.TempDir(), "missing.json"), ModeReplay)
+	require.True(t, errors.Is(err, os.ErrNotExist))
+}
+
+func TestModeFromEnv(t *testing.T) {
+	t.Setenv(RecordEnv, "")
+	require.Equal(t, ModeReplay, ModeFromEnv())
+	t.Setenv(RecordEnv, "1")
+	require.Equal(t, ModeRecord, ModeFromEnv())
+}
+
+func TestRedactJSON(t *testing.T) {
+	require.Equal(t, `{"refresh_token" : "REDACTED","id":"x"}`, redactJSON(`{"refresh_token" : "abc","id":"x"}`))
+	require.True(t, strings.HasPrefix(redactJSON(`{"access_token":""}`), `{"access_token":"REDACTED"`))
+}
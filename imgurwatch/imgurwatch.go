@@ -0,0 +1,161 @@
+// Package imgurwatch uploads the files created in a directory, e.g. new screenshots.
+//
+//	err := imgurwatch.Watch(ctx, client, dir, imgurwatch.Options{}, func(u imgurwatch.Upload) {
+//		if u.Err == nil {
+//			fmt.Println(u.Image.Link)
+//		}
+//	})
+//
+// The directory is monitored with fsnotify, so files are picked up as soon as they
+// are written. The package is a module of its own, so the imgur package does not
+// depend on fsnotify.
+package imgurwatch
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/koffeinsource/go-imgur"
+)
+
+// DefaultSettle is how long a file has to stay unchanged before it is uploaded
+const DefaultSettle = time.Second
+
+// DefaultExtensions are the extensions of the uploaded files if Options.Extensions is empty,
+// all file types imgur accepts
+var DefaultExtensions = imgur.UploadExtensions()
+
+// Options configure Watch
+type Options struct {
+	Settle     time.Duration        // How long a file has to stay unchanged before it is uploaded, DefaultSettle if 0
+	Extensions []string             // The extensions of the files to upload, compared case-insensitively, DefaultExtensions if empty
+	Upload     []imgur.UploadOption // Applied to every upload, e.g. imgur.WithAlbum
+}
+
+// Upload is the outcome of uploading a file created in the watched directory
+type Upload struct {
+	Path  string           // Path of the uploaded file
+	Image *imgur.ImageInfo // The uploaded image, nil if the upload failed
+	Err   error            // Why the upload failed
+}
+
+// Watch uploads every file that is created or replaced in dir until ctx is done, and
+// calls handler with the outcome of every upload. Files are uploaded one after another
+// once they were not written to for opts.Settle, hidden files and subdirectories are ignored.
+// It returns the error of ctx, or why dir can not be watched.
+func Watch(ctx context.Context, client *imgur.Client, dir string, opts Options, handler func(Upload)) error {
+	settle := opts.Settle
+	if settle <= 0 {
+		settle = DefaultSettle
+	}
+	extensions := opts.Extensions
+	if len(extensions) == 0 {
+		extensions = DefaultExtensions
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("Could not watch %v - %w", dir, err)
+	}
+	defer watcher.Close()
+	if err := watcher.Add(dir); err != nil {
+		return fmt.Errorf("Could not watch %v - %w", dir, err)
+	}
+
+	// pending are the files waiting to settle and when they were last written
+	pending := map[string]time.Time{}
+	timer := time.NewTimer(settle)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return ctx.Err()
+			}
+			switch {
+			case !watched(event.Name, extensions):
+			case event.Has(fsnotify.Create) || event.Has(fsnotify.Write):
+				pending[event.Name] = time.Now()
+			case event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename):
+				delete(pending, event.Name)
+			}
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return ctx.Err()
+			}
+			client.Log.Warningf("Watching %v failed: %v", dir, err)
+
+		case <-timer.C:
+			for _, name := range settled(pending, settle) {
+				delete(pending, name)
+				if info, err := os.Stat(name); err != nil || !info.Mode().IsRegular() {
+					continue
+				}
+				img, _, err := client.UploadFromFS(ctx, os.DirFS(filepath.Dir(name)), filepath.Base(name), opts.Upload...)
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				handler(Upload{Path: name, Image: img, Err: err})
+			}
+		}
+
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(nextCheck(pending, settle))
+	}
+}
+
+// watched reports whether the file name is uploaded once it settled
+func watched(name string, extensions []string) bool {
+	if strings.HasPrefix(filepath.Base(name), ".") {
+		return false
+	}
+	ext := filepath.Ext(name)
+	for _, e := range extensions {
+		if strings.EqualFold(e, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// settled returns the pending files that were not written to for settle, oldest first
+func settled(pending map[string]time.Time, settle time.Duration) []string {
+	var names []string
+	for name, written := range pending {
+		if time.Since(written) >= settle {
+			names = append(names, name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool { return pending[names[i]].Before(pending[names[j]]) })
+	return names
+}
+
+// nextCheck returns how long to wait until the next pending file settles, settle if
+// there is none
+func nextCheck(pending map[string]time.Time, settle time.Duration) time.Duration {
+	wait := settle
+	for _, written := range pending {
+		if d := time.Until(written.Add(settle)); d < wait {
+			wait = d
+		}
+	}
+	if wait < 0 {
+		wait = 0
+	}
+	return wait
+}
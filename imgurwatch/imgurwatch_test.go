@@ -0,0 +1,83 @@
+package imgurwatch
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/koffeinsource/go-imgur/imgurtest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatch(t *testing.T) {
+	srv := imgurtest.NewServer()
+	defer srv.Close()
+	client, err := srv.NewClient()
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	uploads := make(chan Upload, 10)
+	done := make(chan error, 1)
+	go func() {
+		done <- Watch(ctx, client, dir, Options{Settle: 20 * time.Millisecond}, func(u Upload) {
+			uploads <- u
+		})
+	}()
+	// give the watcher time to start
+	time.Sleep(50 * time.Millisecond)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("not an image"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".hidden.png"), []byte("\x89PNG\r\n\x1a\n"), 0600))
+	name := filepath.Join(dir, "Screenshot.PNG")
+	require.NoError(t, os.WriteFile(name, []byte("\x89PNG\r\n\x1a\n screenshot"), 0600))
+
+	select {
+	case u := <-uploads:
+		require.NoError(t, u.Err)
+		require.Equal(t, name, u.Path)
+		_, ok := srv.Image(u.Image.ID)
+		require.True(t, ok)
+	case <-time.After(5 * time.Second):
+		t.Fatal("file was not uploaded")
+	}
+
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+	require.Empty(t, uploads)
+	require.Equal(t, 1, srv.Images())
+}
+
+func TestWatchMissingDirectory(t *testing.T) {
+	srv := imgurtest.NewServer()
+	defer srv.Close()
+	client, err := srv.NewClient()
+	require.NoError(t, err)
+
+	err = Watch(context.Background(), client, filepath.Join(t.TempDir(), "missing"), Options{}, func(Upload) {})
+	require.Error(t, err)
+}
+
+func TestSettled(t *testing.T) {
+	now := time.Now()
+	pending := map[string]time.Time{
+		"b": now.Add(-2 * time.Second),
+		"a": now.Add(-3 * time.Second),
+		"c": now,
+	}
+	require.Equal(t, []string{"a", "b"}, settled(pending, time.Second))
+	require.Equal(t, time.Duration(0), nextCheck(pending, time.Second))
+	require.InDelta(t, float64(time.Second), float64(nextCheck(map[string]time.Time{"c": now}, time.Second)), float64(100*time.Millisecond))
+	require.Equal(t, time.Second, nextCheck(nil, time.Second))
+}
+
+func TestWatchedDefaultExtensions(t *testing.T) {
+	for _, name := range []string{"a.png", "b.APNG", "c.tif", "d.avi", "e.bmp"} {
+		require.True(t, watched(name, DefaultExtensions), name)
+	}
+	require.False(t, watched("notes.txt", DefaultExtensions))
+	require.False(t, watched(".hidden.png", DefaultExtensions))
+}
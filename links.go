@@ -0,0 +1,84 @@
+package imgur
+
+import (
+	"path"
+	"strings"
+)
+
+// ThumbnailSize is the suffix imgur appends to an image ID to serve a smaller version
+type ThumbnailSize string
+
+// Thumbnail sizes served by imgur. Square thumbnails are cropped, all others keep the aspect ratio.
+const (
+	ThumbSmallSquare ThumbnailSize = "s" // 90x90
+	ThumbBigSquare   ThumbnailSize = "b" // 160x160
+	ThumbSmall       ThumbnailSize = "t" // 160x160
+	ThumbMedium      ThumbnailSize = "m" // 320x320
+	ThumbLarge       ThumbnailSize = "l" // 640x640
+	ThumbHuge        ThumbnailSize = "h" // 1024x1024
+)
+
+const (
+	directBaseURL = "https://i.imgur.com/"
+	pageBaseURL   = "https://imgur.com/"
+)
+
+// DirectURL returns the link to the file of an image. ext includes the dot, like ".png".
+func DirectURL(id ImageID, ext string) string {
+	return directBaseURL + string(id) + ext
+}
+
+// ThumbnailURL returns the link to a thumbnail of an image. An empty ext defaults to ".jpg",
+// which imgur serves for every image and video.
+func ThumbnailURL(id ImageID, size ThumbnailSize, ext string) string {
+	if ext == "" {
+		ext = ".jpg"
+	}
+	return directBaseURL + string(id) + string(size) + ext
+}
+
+// PageURL returns the link to the imgur page of an image
+func PageURL(id ImageID) string {
+	return pageBaseURL + string(id)
+}
+
+// AlbumPageURL returns the link to the imgur page of an album
+func AlbumPageURL(id AlbumID) string {
+	return pageBaseURL + "a/" + string(id)
+}
+
+// GalleryPageURL returns the link to a post in the public gallery
+func GalleryPageURL(post PostRef) string {
+	return pageBaseURL + "gallery/" + refString(post)
+}
+
+// DirectURL returns the direct link to the image file, Link if imgur returned one
+func (ii *ImageInfo) DirectURL() string {
+	if ii.Link != "" {
+		return ii.Link
+	}
+	return DirectURL(ii.ID, mimeExtension(ii.MimeType))
+}
+
+// Thumbnail returns the link to a thumbnail of the image. Videos and animations
+// get a still .jpg thumbnail.
+func (ii *ImageInfo) Thumbnail(size ThumbnailSize) string {
+	ext := path.Ext(ii.Link)
+	if ii.Animated || strings.HasPrefix(ii.MimeType, "video/") || ext == "" {
+		ext = ".jpg"
+	}
+	return ThumbnailURL(ii.ID, size, ext)
+}
+
+// PageURL returns the link to the imgur page of the image
+func (ii *ImageInfo) PageURL() string {
+	return PageURL(ii.ID)
+}
+
+// mimeExtension returns the file extension imgur uses for a MIME type, .jpg for unknown types
+func mimeExtension(mimeType string) string {
+	if ext := uploadTypes[mimeType]; ext != "" {
+		return ext
+	}
+	return ".jpg"
+}
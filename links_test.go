@@ -0,0 +1,37 @@
+package imgur
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkBuilders(t *testing.T) {
+	require.Equal(t, "https://i.imgur.com/ClF8rLe.png", DirectURL("ClF8rLe", ".png"))
+	require.Equal(t, "https://i.imgur.com/ClF8rLem.jpg", ThumbnailURL("ClF8rLe", ThumbMedium, ""))
+	require.Equal(t, "https://imgur.com/ClF8rLe", PageURL("ClF8rLe"))
+	require.Equal(t, "https://imgur.com/a/VZQXk", AlbumPageURL("VZQXk"))
+	require.Equal(t, "https://imgur.com/gallery/VZQXk", GalleryPageURL(AlbumID("VZQXk")))
+}
+
+func TestImageInfoLinks(t *testing.T) {
+	img := &ImageInfo{ID: "ClF8rLe", MimeType: "image/png", Link: "https://i.imgur.com/ClF8rLe.png"}
+	require.Equal(t, "https://i.imgur.com/ClF8rLe.png", img.DirectURL())
+	require.Equal(t, "https://i.imgur.com/ClF8rLes.png", img.Thumbnail(ThumbSmallSquare))
+	require.Equal(t, "https://imgur.com/ClF8rLe", img.PageURL())
+
+	video := &ImageInfo{ID: "xZ3bTd2", MimeType: "video/mp4"}
+	require.Equal(t, "https://i.imgur.com/xZ3bTd2.mp4", video.DirectURL())
+	require.Equal(t, "https://i.imgur.com/xZ3bTd2h.jpg", video.Thumbnail(ThumbHuge))
+
+	for mimeType, link := range map[string]string{
+		"image/webp": "https://i.imgur.com/ClF8rLe.webp",
+		"image/tiff": "https://i.imgur.com/ClF8rLe.tiff",
+		"video/webm": "https://i.imgur.com/ClF8rLe.webm",
+		"image/jpeg": "https://i.imgur.com/ClF8rLe.jpg",
+		"":           "https://i.imgur.com/ClF8rLe.jpg",
+	} {
+		img := &ImageInfo{ID: "ClF8rLe", MimeType: mimeType}
+		require.Equal(t, link, img.DirectURL(), mimeType)
+	}
+}
@@ -0,0 +1,178 @@
+package imgur
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+)
+
+// listStream decodes the items of a listing one by one while the response is read, so
+// the whole listing is never held in memory
+type listStream struct {
+	body    io.Closer
+	dec     *json.Decoder
+	method  string
+	URL     string
+	status  int // HTTP status, replaced by the status of the envelope
+	inData  bool
+	raw     bool // if the JSON of the items is kept, see WithRawJSON
+	success bool
+	message string
+}
+
+// openList requests the listing at path and reads its response up to the first item
+func (client *Client) openList(ctx context.Context, path string, params url.Values) (*listStream, error) {
+	URL := client.createAPIURL(path)
+	if len(params) > 0 {
+		URL += "?" + params.Encode()
+	}
+	client.Log.Infof("Requesting GET %v\n", URL)
+	req, err := client.newRequest(ctx, "GET", URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Could not create request for %v - %w", URL, err)
+	}
+	res, err := client.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Could not GET %v - %w", URL, err)
+	}
+
+	if !(res.StatusCode >= 200 && res.StatusCode <= 300) {
+		defer res.Body.Close()
+		raw, err := client.readBody(res.Body)
+		if err != nil {
+			return nil, fmt.Errorf("Problem reading the body for %v - %w", URL, err)
+		}
+		return nil, NewAPIError(req.Method, URL, res.StatusCode, raw)
+	}
+	body, page, err := jsonBody(limitBody(res.Body, client.maxResponseSize))
+	if err != nil {
+		res.Body.Close()
+		return nil, fmt.Errorf("Problem reading the body for %v - %w", URL, err)
+	}
+	if page != nil {
+		res.Body.Close()
+		return nil, NewAPIError(req.Method, URL, res.StatusCode, page)
+	}
+
+	s := &listStream{body: res.Body, dec: json.NewDecoder(body), method: req.Method, URL: URL, status: res.StatusCode, raw: client.rawJSON}
+	if err := s.expect(json.Delim('{')); err != nil {
+		s.close()
+		return nil, err
+	}
+	if err := s.readEnvelope(); err != nil {
+		s.close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// next decodes the next item of the listing into v and reports whether there was one.
+// At the end of the listing the rest of the response is read and the body closed.
+func (s *listStream) next(v interface{}) (bool, error) {
+	if !s.inData {
+		return false, nil
+	}
+	if s.dec.More() {
+		if !s.raw {
+			if err := s.dec.Decode(v); err != nil {
+				return false, fmt.Errorf("Problem decoding json for %v - %w", s.URL, err)
+			}
+			return true, nil
+		}
+		var raw json.RawMessage
+		if err := s.dec.Decode(&raw); err != nil {
+			return false, fmt.Errorf("Problem decoding json for %v - %w", s.URL, err)
+		}
+		if err := json.Unmarshal(raw, v); err != nil {
+			return false, fmt.Errorf("Problem decoding json for %v - %w", s.URL, err)
+		}
+		return true, setRawJSON(raw, v)
+	}
+	// the closing ] of data
+	if _, err := s.dec.Token(); err != nil {
+		return false, fmt.Errorf("Problem decoding json for %v - %w", s.URL, err)
+	}
+	s.inData = false
+	return false, s.readEnvelope()
+}
+
+// readEnvelope reads the fields of the response envelope, after its opening { or the
+// items of data, until the items of data start or the envelope ends. An unsuccessful
+// response is returned as APIError.
+func (s *listStream) readEnvelope() error {
+	for s.dec.More() {
+		t, err := s.dec.Token()
+		if err != nil {
+			return fmt.Errorf("Problem decoding json for %v - %w", s.URL, err)
+		}
+		var value interface{}
+		switch t {
+		case "data":
+			t, err := s.dec.Token()
+			if err != nil {
+				return fmt.Errorf("Problem decoding json for %v - %w", s.URL, err)
+			}
+			switch t {
+			case json.Delim('['):
+				s.inData = true
+				return nil
+			case json.Delim('{'):
+				if err := s.readError(); err != nil {
+					return err
+				}
+			}
+			continue
+		case "success":
+			value = &s.success
+		case "status":
+			value = &s.status
+		default:
+			value = new(json.RawMessage)
+		}
+		if err := s.dec.Decode(value); err != nil {
+			return fmt.Errorf("Problem decoding json for %v - %w", s.URL, err)
+		}
+	}
+	if err := s.expect(json.Delim('}')); err != nil {
+		return err
+	}
+	s.close()
+	if !s.success {
+		return &APIError{StatusCode: s.status, Message: s.message, Method: s.method, URL: s.URL}
+	}
+	return nil
+}
+
+// readError reads the object imgur sends as data of an unsuccessful response, its opening
+// { was already read
+func (s *listStream) readError() error {
+	for s.dec.More() {
+		t, err := s.dec.Token()
+		if err != nil {
+			return fmt.Errorf("Problem decoding json for %v - %w", s.URL, err)
+		}
+		var raw json.RawMessage
+		if err := s.dec.Decode(&raw); err != nil {
+			return fmt.Errorf("Problem decoding json for %v - %w", s.URL, err)
+		}
+		if t == "error" {
+			s.message = errorMessage(raw)
+		}
+	}
+	return s.expect(json.Delim('}'))
+}
+
+func (s *listStream) expect(delim json.Delim) error {
+	if err := expectDelim(s.dec, delim); err != nil {
+		return fmt.Errorf("Problem decoding json for %v - %w", s.URL, err)
+	}
+	return nil
+}
+
+// close releases the response, the rest of the listing is not read
+func (s *listStream) close() error {
+	s.inData = false
+	return s.body.Close()
+}
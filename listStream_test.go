@@ -0,0 +1,96 @@
+package imgur
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListStreamIncremental(t *testing.T) {
+	consumed := make(chan struct{})
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/3/account/me/images/0" {
+			fmt.Fprint(w, `{"data":[],"success":true,"status":200}`)
+			return
+		}
+		fmt.Fprint(w, `{"data":[{"id":"a"},`)
+		w.(http.Flusher).Flush()
+		// the first image is delivered before the rest of the page is sent
+		<-consumed
+		fmt.Fprint(w, `{"id":"b"}],"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	it := client.AccountImages(Me)
+	require.True(t, it.Next(context.Background()))
+	require.Equal(t, ImageID("a"), it.Image().ID)
+	close(consumed)
+	require.True(t, it.Next(context.Background()))
+	require.Equal(t, ImageID("b"), it.Image().ID)
+	require.False(t, it.Next(context.Background()))
+	require.NoError(t, it.Err())
+}
+
+func TestListStreamEnvelope(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/3/account/first/images/0":
+			// the fields of the envelope may come in any order
+			fmt.Fprint(w, `{"status":200,"extra":{"a":[1,2]},"success":true,"data":[{"id":"a"}]}`)
+		case "/3/account/failed/images/0":
+			fmt.Fprint(w, `{"data":{"error":"Imgur is temporarily over capacity","request":"\/3\/account\/failed\/images\/0","method":"GET"},"success":false,"status":503}`)
+		case "/3/account/broken/images/0":
+			fmt.Fprint(w, `{"data":[{"id":"a"},{"id":`)
+		case "/3/account/page/images/0":
+			fmt.Fprint(w, `<html><title>Over capacity</title></html>`)
+		default:
+			fmt.Fprint(w, `{"data":[],"success":true,"status":200}`)
+		}
+	})
+	defer server.Close()
+	client, _ := NewClient(httpC, "testing", "", WithRetryPolicy(testRetryPolicy))
+
+	it := client.AccountImages("first")
+	require.True(t, it.Next(context.Background()))
+	require.Equal(t, ImageID("a"), it.Image().ID)
+	require.False(t, it.Next(context.Background()))
+	require.NoError(t, it.Err())
+
+	it = client.AccountImages("failed")
+	require.False(t, it.Next(context.Background()))
+	var apiErr *APIError
+	require.True(t, errors.As(it.Err(), &apiErr))
+	require.Equal(t, 503, apiErr.StatusCode)
+	require.Equal(t, "Imgur is temporarily over capacity", apiErr.Message)
+
+	it = client.AccountImages("broken")
+	require.True(t, it.Next(context.Background()))
+	require.False(t, it.Next(context.Background()))
+	require.Error(t, it.Err())
+
+	it = client.AccountImages("page")
+	require.False(t, it.Next(context.Background()))
+	require.True(t, errors.Is(it.Err(), ErrServiceUnavailable))
+}
+
+func TestListStreamClose(t *testing.T) {
+	requests := 0
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"data":[{"id":"a"},{"id":"b"}],"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	it := client.AccountImages("Locker")
+	require.True(t, it.Next(context.Background()))
+	require.NoError(t, it.Close())
+	require.False(t, it.Next(context.Background()))
+	require.NoError(t, it.Err())
+	require.Equal(t, 1, requests)
+}
@@ -0,0 +1,49 @@
+package imgur
+
+// Logger is used by the client to report what it is doing.
+// klogger.KLogger satisfies it, other logging libraries need a small adapter.
+type Logger interface {
+	Debugf(format string, args ...interface{})
+	Infof(format string, args ...interface{})
+	Warningf(format string, args ...interface{})
+	Errorf(format string, args ...interface{})
+}
+
+// NopLogger discards all messages. It is the default logger of a client.
+type NopLogger struct{}
+
+// Debugf discards the message
+func (NopLogger) Debugf(format string, args ...interface{}) {}
+
+// Infof discards the message
+func (NopLogger) Infof(format string, args ...interface{}) {}
+
+// Warningf discards the message
+func (NopLogger) Warningf(format string, args ...interface{}) {}
+
+// Errorf discards the message
+func (NopLogger) Errorf(format string, args ...interface{}) {}
+
+// PrintfLogger writes all messages with a printf like function, e.g. log.Printf
+// or testing.T.Logf, prefixed by their level.
+type PrintfLogger func(format string, args ...interface{})
+
+// Debugf writes the message at debug level
+func (p PrintfLogger) Debugf(format string, args ...interface{}) {
+	p("Debug: "+format, args...)
+}
+
+// Infof writes the message at info level
+func (p PrintfLogger) Infof(format string, args ...interface{}) {
+	p("Info: "+format, args...)
+}
+
+// Warningf writes the message at warning level
+func (p PrintfLogger) Warningf(format string, args ...interface{}) {
+	p("Warning: "+format, args...)
+}
+
+// Errorf writes the message at error level
+func (p PrintfLogger) Errorf(format string, args ...interface{}) {
+	p("Error: "+format, args...)
+}
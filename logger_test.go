@@ -0,0 +1,41 @@
+package imgur
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/koffeinsource/go-klogger"
+	"github.com/stretchr/testify/require"
+)
+
+var _ Logger = klogger.KLogger(nil)
+
+func TestInjectedLogger(t *testing.T) {
+	httpC, server := testHTTPClientJSON(`{"data":{"id":"ClF8rLe"},"success":true,"status":200}`)
+	defer server.Close()
+
+	var lines []string
+	logger := PrintfLogger(func(format string, args ...interface{}) {
+		lines = append(lines, fmt.Sprintf(format, args...))
+	})
+
+	client, err := New("testing", WithHTTPClient(httpC), WithLogger(logger))
+	require.NoError(t, err)
+	_, _, err = client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+
+	require.NotEmpty(t, lines)
+	require.True(t, strings.HasPrefix(lines[len(lines)-1], "Info: Requesting URL https://api.imgur.com/3/image/ClF8rLe"))
+}
+
+func TestDefaultLogger(t *testing.T) {
+	client, err := New("testing")
+	require.NoError(t, err)
+	require.Equal(t, NopLogger{}, client.Log)
+
+	client, err = NewClient(new(http.Client), "testing", "", WithLogger(nil))
+	require.NoError(t, err)
+	require.Equal(t, NopLogger{}, client.Log)
+}
@@ -0,0 +1,55 @@
+package imgur
+
+import "container/list"
+
+// lru is a map evicting the least recently used entry once it is full.
+// It is not safe for concurrent use.
+type lru struct {
+	maxEntries int        // below 1 means no limit
+	order      *list.List // of *lruEntry, most recently used first
+	entries    map[string]*list.Element
+}
+
+type lruEntry struct {
+	key   string
+	value interface{}
+}
+
+func newLRU(maxEntries int) *lru {
+	return &lru{maxEntries: maxEntries, order: list.New(), entries: map[string]*list.Element{}}
+}
+
+// get returns the value of key and marks it as recently used
+func (l *lru) get(key string) (interface{}, bool) {
+	e, ok := l.entries[key]
+	if !ok {
+		return nil, false
+	}
+	l.order.MoveToFront(e)
+	return e.Value.(*lruEntry).value, true
+}
+
+// set stores value for key and evicts the least recently used entry if l is full
+func (l *lru) set(key string, value interface{}) {
+	if e, ok := l.entries[key]; ok {
+		e.Value.(*lruEntry).value = value
+		l.order.MoveToFront(e)
+		return
+	}
+	l.entries[key] = l.order.PushFront(&lruEntry{key: key, value: value})
+	if l.maxEntries > 0 && l.order.Len() > l.maxEntries {
+		l.remove(l.order.Back().Value.(*lruEntry).key)
+	}
+}
+
+// remove deletes key
+func (l *lru) remove(key string) {
+	if e, ok := l.entries[key]; ok {
+		l.order.Remove(e)
+		delete(l.entries, key)
+	}
+}
+
+func (l *lru) len() int {
+	return l.order.Len()
+}
@@ -0,0 +1,47 @@
+package imgur
+
+import (
+	"context"
+	"time"
+)
+
+// MetricsRecorder collects metrics of the API requests of a client, see WithMetrics.
+// The methods are called after every request and have to be safe for concurrent use.
+type MetricsRecorder interface {
+	// ObserveRequest records a finished request to endpoint, e.g. "image/{id}".
+	// status is the HTTP status of the response, 0 if none was received, and
+	// duration includes retries and waiting for credits.
+	ObserveRequest(method string, endpoint string, status int, duration time.Duration)
+	// ObserveBytesSent records the size of the bodies sent with a request, e.g. of an upload.
+	// It is only called for requests with a body.
+	ObserveBytesSent(method string, endpoint string, bytes int64)
+	// ObserveRateLimit records the credits imgur reported with a response
+	ObserveRateLimit(rl RateLimit)
+}
+
+// WithMetrics reports the outcome of every API request of the client to recorder
+func WithMetrics(recorder MetricsRecorder) ClientOption {
+	if recorder == nil {
+		return WithCallHook(nil)
+	}
+	return WithCallHook(metricsHook{recorder})
+}
+
+// metricsHook is the CallHook feeding a MetricsRecorder
+type metricsHook struct {
+	recorder MetricsRecorder
+}
+
+func (h metricsHook) StartCall(ctx context.Context, method string, endpoint string) context.Context {
+	return ctx
+}
+
+func (h metricsHook) EndCall(ctx context.Context, info CallInfo) {
+	h.recorder.ObserveRequest(info.Method, info.Endpoint, info.StatusCode, info.Duration)
+	if info.BytesSent > 0 {
+		h.recorder.ObserveBytesSent(info.Method, info.Endpoint, info.BytesSent)
+	}
+	if info.RateLimit != nil {
+		h.recorder.ObserveRateLimit(*info.RateLimit)
+	}
+}
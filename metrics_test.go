@@ -0,0 +1,74 @@
+package imgur
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testMetrics struct {
+	mu         sync.Mutex
+	requests   []string
+	bytesSent  map[string]int64
+	rateLimits []RateLimit
+}
+
+func (m *testMetrics) ObserveRequest(method string, endpoint string, status int, duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests = append(m.requests, method+" "+endpoint+" "+http.StatusText(status))
+}
+
+func (m *testMetrics) ObserveBytesSent(method string, endpoint string, bytes int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.bytesSent[method+" "+endpoint] += bytes
+}
+
+func (m *testMetrics) ObserveRateLimit(rl RateLimit) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.rateLimits = append(m.rateLimits, rl)
+}
+
+func TestWithMetrics(t *testing.T) {
+	uploads := 0
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/3/image" {
+			uploads++
+			r.ParseMultipartForm(1 << 20)
+			if uploads == 1 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(503)
+				return
+			}
+		}
+		w.Header().Set("X-RateLimit-UserLimit", "10")
+		w.Header().Set("X-RateLimit-UserRemaining", "2")
+		w.Write([]byte(`{"data":{"id":"ClF8rLe"},"success":true,"status":200}`))
+	})
+	defer server.Close()
+
+	metrics := &testMetrics{bytesSent: map[string]int64{}}
+	client, err := New("id", WithHTTPClient(httpC), WithMetrics(metrics), WithMetrics(nil),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}))
+	require.NoError(t, err)
+
+	data := strings.Repeat("x", 1000)
+	_, _, err = client.Upload(context.Background(), BytesSource([]byte(data)))
+	require.NoError(t, err)
+	_, _, err = client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"POST image OK", "GET image/{id} OK"}, metrics.requests)
+	require.Len(t, metrics.bytesSent, 1)
+	// the upload was sent twice, both times with the multipart envelope
+	require.Greater(t, metrics.bytesSent["POST image"], int64(2*len(data)))
+	require.Len(t, metrics.rateLimits, 2)
+	require.Equal(t, int64(2), metrics.rateLimits[1].UserRemaining)
+}
@@ -0,0 +1,89 @@
+package imgur
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Notification informs the user about a reply to a comment or a new message
+type Notification struct {
+	ID        int           // The ID of the notification
+	AccountID int           // The account ID the notification belongs to
+	Viewed    bool          // If the notification was marked as viewed
+	Reply     *Comment      // The reply, only set for reply notifications
+	Message   *Conversation // The conversation with the new message, only set for message notifications
+}
+
+// Notifications are the notifications of the user of an authenticated client
+type Notifications struct {
+	Replies  []Notification // Replies to comments of the user
+	Messages []Notification // New direct messages
+	Limit    *RateLimit     // Current rate limit
+}
+
+type notificationInternal struct {
+	ID        int             `json:"id"`
+	AccountID int             `json:"account_id"`
+	Viewed    bool            `json:"viewed"`
+	Content   json.RawMessage `json:"content"`
+}
+
+// GetNotifications queries imgur for the notifications of the user, which requires an
+// authenticated client. With onlyNew, notifications that were marked as viewed are left out.
+// returns the notifications, status code of the request, error
+func (client *Client) GetNotifications(ctx context.Context, onlyNew bool) (*Notifications, int, error) {
+	if !client.authenticated() {
+		return nil, -1, fmt.Errorf("Requesting notifications requires an access token - %w", ErrUnauthorized)
+	}
+
+	var data struct {
+		Replies  []notificationInternal `json:"replies"`
+		Messages []notificationInternal `json:"messages"`
+	}
+	rl, status, err := client.send(ctx, "GET", "notification", url.Values{"new": {strconv.FormatBool(onlyNew)}}, &data)
+	if err != nil {
+		return nil, status, fmt.Errorf("Problem getting notifications - %w", err)
+	}
+
+	n := &Notifications{Limit: rl}
+	for _, r := range data.Replies {
+		reply := &Comment{}
+		if err := json.Unmarshal(r.Content, reply); err != nil {
+			return nil, status, fmt.Errorf("Problem decoding reply of notification %v - %w", r.ID, err)
+		}
+		n.Replies = append(n.Replies, Notification{ID: r.ID, AccountID: r.AccountID, Viewed: r.Viewed, Reply: reply})
+	}
+	for _, m := range data.Messages {
+		message := &Conversation{}
+		if err := json.Unmarshal(m.Content, message); err != nil {
+			return nil, status, fmt.Errorf("Problem decoding message of notification %v - %w", m.ID, err)
+		}
+		n.Messages = append(n.Messages, Notification{ID: m.ID, AccountID: m.AccountID, Viewed: m.Viewed, Message: message})
+	}
+	return n, status, nil
+}
+
+// MarkNotificationsViewed marks notifications as viewed, so they are not returned as new anymore.
+// returns status code of the request, error
+func (client *Client) MarkNotificationsViewed(ctx context.Context, ids ...int) (int, error) {
+	if len(ids) == 0 {
+		return -1, fmt.Errorf("No notifications given")
+	}
+	if !client.authenticated() {
+		return -1, fmt.Errorf("Marking notifications requires an access token - %w", ErrUnauthorized)
+	}
+
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = strconv.Itoa(id)
+	}
+	_, status, err := client.send(ctx, "POST", "notification", url.Values{"ids": {strings.Join(s, ",")}}, nil)
+	if err != nil {
+		return status, fmt.Errorf("Problem marking notifications as viewed - %w", err)
+	}
+	return status, nil
+}
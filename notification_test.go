@@ -0,0 +1,45 @@
+package imgur
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const notificationsJSON = `{"data":{"replies":[{"id":5,"account_id":42,"viewed":false,"content":{"id":2,"parent_id":1,"comment":"Agreed","author":"friend"}}],"messages":[{"id":6,"account_id":42,"viewed":false,"content":{"id":12,"with_account":"friend","last_message_preview":"Hi"}}]},"success":true,"status":200}`
+
+func TestGetNotifications(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/3/notification", r.URL.Path)
+		if r.Method == "POST" {
+			require.NoError(t, r.ParseForm())
+			require.Equal(t, "5,6", r.PostForm.Get("ids"))
+			fmt.Fprint(w, `{"data":true,"success":true,"status":200}`)
+			return
+		}
+		require.Equal(t, "true", r.URL.Query().Get("new"))
+		fmt.Fprint(w, notificationsJSON)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	n, status, err := client.GetNotifications(context.Background(), true)
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Len(t, n.Replies, 1)
+	require.Equal(t, "Agreed", n.Replies[0].Reply.Comment)
+	require.Nil(t, n.Replies[0].Message)
+	require.Len(t, n.Messages, 1)
+	require.Equal(t, "friend", n.Messages[0].Message.WithAccount)
+
+	_, err = client.MarkNotificationsViewed(context.Background(), 5, 6)
+	require.NoError(t, err)
+
+	anonymous, _ := NewClient(httpC, "testing", "")
+	_, _, err = anonymous.GetNotifications(context.Background(), true)
+	require.True(t, errors.Is(err, ErrUnauthorized))
+}
@@ -0,0 +1,152 @@
+// Package oauth implements the OAuth2 authorization code and PIN flows of imgur.
+//
+// Send the user to the URL returned by AuthCodeURL. Imgur redirects back to the
+// callback registered for the application with a code, which Exchange turns
+// into a token. Client creates an imgur client acting on behalf of the user,
+// TokenSource keeps the token of such a client valid.
+//
+// Applications that can not host a callback, like command line tools, use the PIN
+// flow instead: the user opens AuthorizePinURL, imgur shows a PIN after access was
+// granted and the user enters it in the application, which passes it to ExchangePin.
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/koffeinsource/go-imgur"
+)
+
+// DefaultEndpoint is the base URL of the imgur OAuth2 API
+const DefaultEndpoint = "https://api.imgur.com/oauth2/"
+
+// Config describes an application registered at imgur
+type Config struct {
+	ClientID     string       // The client_id obtained during application registration
+	ClientSecret string       // The client secret obtained during application registration
+	Endpoint     string       // Base URL of the OAuth2 API, DefaultEndpoint if empty
+	HTTPClient   *http.Client // Client used for the token requests, http.DefaultClient if nil
+	UserAgent    string       // Sent with the token requests, imgur.DefaultUserAgent if empty
+}
+
+func (c *Config) endpoint(path string) string {
+	endpoint := c.Endpoint
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+	if !strings.HasSuffix(endpoint, "/") {
+		endpoint += "/"
+	}
+	return endpoint + path
+}
+
+func (c *Config) httpClient() *http.Client {
+	if c.HTTPClient == nil {
+		return http.DefaultClient
+	}
+	return c.HTTPClient
+}
+
+func (c *Config) userAgent() string {
+	if c.UserAgent == "" {
+		return imgur.DefaultUserAgent
+	}
+	return c.UserAgent
+}
+
+// AuthCodeURL returns the URL the user has to visit to grant the application access.
+// state is passed back unchanged to the callback and should be used to prevent CSRF.
+func (c *Config) AuthCodeURL(state string) string {
+	return c.authorizeURL("code", state)
+}
+
+// AuthorizePinURL returns the URL the user has to visit to grant the application access
+// with the PIN flow. Imgur shows a PIN afterwards, which ExchangePin turns into a token.
+// state is passed back unchanged and may be empty.
+func (c *Config) AuthorizePinURL(state string) string {
+	return c.authorizeURL("pin", state)
+}
+
+func (c *Config) authorizeURL(responseType string, state string) string {
+	v := url.Values{}
+	v.Set("client_id", c.ClientID)
+	v.Set("response_type", responseType)
+	if state != "" {
+		v.Set("state", state)
+	}
+	return c.endpoint("authorize") + "?" + v.Encode()
+}
+
+// Exchange trades the code imgur passed to the callback for a token.
+func (c *Config) Exchange(ctx context.Context, code string) (*imgur.Token, error) {
+	if code == "" {
+		return nil, fmt.Errorf("Authorization code is empty")
+	}
+	return c.requestToken(ctx, url.Values{
+		"grant_type": {"authorization_code"},
+		"code":       {code},
+	})
+}
+
+// ExchangePin trades the PIN imgur showed the user for a token.
+func (c *Config) ExchangePin(ctx context.Context, pin string) (*imgur.Token, error) {
+	pin = strings.TrimSpace(pin)
+	if pin == "" {
+		return nil, fmt.Errorf("PIN is empty")
+	}
+	return c.requestToken(ctx, url.Values{
+		"grant_type": {"pin"},
+		"pin":        {pin},
+	})
+}
+
+// requestToken posts a grant to the token endpoint
+func (c *Config) requestToken(ctx context.Context, grant url.Values) (*imgur.Token, error) {
+	grant.Set("client_id", c.ClientID)
+	grant.Set("client_secret", c.ClientSecret)
+
+	URL := c.endpoint("token")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, URL, strings.NewReader(grant.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("Could not create request for %v - %w", URL, err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("User-Agent", c.userAgent())
+
+	res, err := c.httpClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Could not post %v - %w", URL, err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("Problem reading the body of %v - %w", URL, err)
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return nil, imgur.NewAPIError(req.Method, URL, res.StatusCode, body)
+	}
+
+	var response imgur.GenerateAccessTokenResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("Problem decoding json of the token response - %w", err)
+	}
+	if response.AccessToken == "" {
+		return nil, fmt.Errorf("Token response of %v contains no access token", URL)
+	}
+	return response.Token(), nil
+}
+
+// Client creates an imgur client that authenticates all requests with token.
+func (c *Config) Client(token *imgur.Token, opts ...imgur.ClientOption) (*imgur.Client, error) {
+	if token == nil || token.AccessToken == "" {
+		return nil, fmt.Errorf("Access token is empty")
+	}
+	opts = append([]imgur.ClientOption{imgur.WithAccessToken(token.AccessToken), imgur.WithUserAgent(c.UserAgent)}, opts...)
+	return imgur.New(c.ClientID, opts...)
+}
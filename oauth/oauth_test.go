@@ -0,0 +1,116 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/koffeinsource/go-imgur"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthCodeURL(t *testing.T) {
+	c := Config{ClientID: "client"}
+	u, err := url.Parse(c.AuthCodeURL("xyz"))
+	require.NoError(t, err)
+	require.Equal(t, "api.imgur.com", u.Host)
+	require.Equal(t, "/oauth2/authorize", u.Path)
+	require.Equal(t, "client", u.Query().Get("client_id"))
+	require.Equal(t, "code", u.Query().Get("response_type"))
+	require.Equal(t, "xyz", u.Query().Get("state"))
+}
+
+func TestExchange(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/oauth2/token", r.URL.Path)
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "authorization_code", r.PostForm.Get("grant_type"))
+		require.Equal(t, "the code", r.PostForm.Get("code"))
+		require.Equal(t, "client", r.PostForm.Get("client_id"))
+		require.Equal(t, "secret", r.PostForm.Get("client_secret"))
+		require.Equal(t, imgur.DefaultUserAgent, r.Header.Get("User-Agent"))
+		fmt.Fprint(w, `{"access_token":"access","expires_in":3600,"token_type":"bearer","scope":null,"refresh_token":"refresh","account_id":42,"account_username":"Locker"}`)
+	}))
+	defer server.Close()
+
+	c := Config{ClientID: "client", ClientSecret: "secret", Endpoint: server.URL + "/oauth2", HTTPClient: server.Client()}
+	token, err := c.Exchange(context.Background(), "the code")
+	require.NoError(t, err)
+	require.Equal(t, "access", token.AccessToken)
+	require.Equal(t, "refresh", token.RefreshToken)
+	require.Equal(t, 42, token.AccountID)
+	require.Equal(t, "Locker", token.AccountUsername)
+	require.WithinDuration(t, time.Now().Add(time.Hour), token.Expiry, time.Minute)
+
+	_, err = c.Exchange(context.Background(), "")
+	require.Error(t, err)
+}
+
+func TestAuthorizePinURL(t *testing.T) {
+	c := Config{ClientID: "client"}
+	u, err := url.Parse(c.AuthorizePinURL(""))
+	require.NoError(t, err)
+	require.Equal(t, "/oauth2/authorize", u.Path)
+	require.Equal(t, "client", u.Query().Get("client_id"))
+	require.Equal(t, "pin", u.Query().Get("response_type"))
+	require.NotContains(t, u.Query(), "state")
+}
+
+func TestExchangePin(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "pin", r.PostForm.Get("grant_type"))
+		require.Equal(t, "1234", r.PostForm.Get("pin"))
+		require.Equal(t, "secret", r.PostForm.Get("client_secret"))
+		fmt.Fprint(w, `{"access_token":"access","expires_in":3600,"token_type":"bearer","refresh_token":"refresh","account_id":42,"account_username":"Locker"}`)
+	}))
+	defer server.Close()
+
+	c := Config{ClientID: "client", ClientSecret: "secret", Endpoint: server.URL + "/oauth2", HTTPClient: server.Client()}
+	token, err := c.ExchangePin(context.Background(), " 1234\n")
+	require.NoError(t, err)
+	require.Equal(t, "access", token.AccessToken)
+	require.Equal(t, "Locker", token.AccountUsername)
+
+	_, err = c.ExchangePin(context.Background(), " ")
+	require.Error(t, err)
+}
+
+func TestExchangeError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(400)
+		fmt.Fprint(w, `{"data":{"error":"Invalid grant_type parameter or parameter missing","request":"\/oauth2\/token","method":"POST"},"success":false,"status":400}`)
+	}))
+	defer server.Close()
+
+	c := Config{ClientID: "client", ClientSecret: "secret", Endpoint: server.URL + "/oauth2/", HTTPClient: server.Client()}
+	_, err := c.Exchange(context.Background(), "the code")
+
+	var apiErr *imgur.APIError
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, 400, apiErr.StatusCode)
+	require.Equal(t, "Invalid grant_type parameter or parameter missing", apiErr.Message)
+}
+
+func TestClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer access", r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"data":{"id":"ClF8rLe"},"success":true,"status":200}`)
+	}))
+	defer server.Close()
+
+	c := Config{ClientID: "client"}
+	client, err := c.Client(&imgur.Token{AccessToken: "access"}, imgur.WithHTTPClient(server.Client()), imgur.WithBaseURL(server.URL+"/3/"))
+	require.NoError(t, err)
+
+	_, _, err = client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+
+	_, err = c.Client(nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,81 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/koffeinsource/go-imgur"
+)
+
+// expiryDelta is how long before its expiry a token is refreshed
+const expiryDelta = time.Minute
+
+// TokenSource returns a token source that starts with token and renews it with its
+// refresh token before it expires or when imgur rejects it. onRefresh is called with
+// every new token, e.g. to persist it, and may be nil.
+//
+//	client, err := imgur.New(config.ClientID, imgur.WithTokenSource(config.TokenSource(token, save)))
+func (c *Config) TokenSource(token *imgur.Token, onRefresh func(*imgur.Token)) imgur.TokenSource {
+	return &refreshTokenSource{config: c, token: token, onRefresh: onRefresh}
+}
+
+type refreshTokenSource struct {
+	config    *Config
+	onRefresh func(*imgur.Token)
+
+	mu    sync.Mutex
+	token *imgur.Token
+}
+
+func (s *refreshTokenSource) Token(ctx context.Context) (*imgur.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token == nil {
+		return nil, fmt.Errorf("No token available")
+	}
+	if s.token.Expiry.IsZero() || time.Until(s.token.Expiry) > expiryDelta {
+		return s.token, nil
+	}
+	return s.refresh(ctx)
+}
+
+func (s *refreshTokenSource) Refresh(ctx context.Context, expired *imgur.Token) (*imgur.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != nil && expired != nil && s.token.AccessToken != expired.AccessToken {
+		// another request already refreshed the token
+		return s.token, nil
+	}
+	return s.refresh(ctx)
+}
+
+// refresh requests a new token, s.mu has to be held
+func (s *refreshTokenSource) refresh(ctx context.Context) (*imgur.Token, error) {
+	if s.token == nil || s.token.RefreshToken == "" {
+		return nil, fmt.Errorf("Refresh token is empty")
+	}
+	token, err := s.config.requestToken(ctx, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {s.token.RefreshToken},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Refreshing the access token failed - %w", err)
+	}
+	// imgur only returns account details with some grants
+	if token.RefreshToken == "" {
+		token.RefreshToken = s.token.RefreshToken
+	}
+	if token.AccountUsername == "" {
+		token.AccountID = s.token.AccountID
+		token.AccountUsername = s.token.AccountUsername
+	}
+
+	s.token = token
+	if s.onRefresh != nil {
+		s.onRefresh(token)
+	}
+	return token, nil
+}
@@ -0,0 +1,114 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/koffeinsource/go-imgur"
+	"github.com/stretchr/testify/require"
+)
+
+// testRefreshServer serves the token endpoint and an image endpoint that only accepts "new"
+func testRefreshServer(t *testing.T, refreshes *int32) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "refresh_token", r.PostForm.Get("grant_type"))
+		require.Equal(t, "refresh", r.PostForm.Get("refresh_token"))
+		atomic.AddInt32(refreshes, 1)
+		fmt.Fprint(w, `{"access_token":"new","expires_in":3600,"token_type":"bearer","refresh_token":"refresh2"}`)
+	})
+	mux.HandleFunc("/3/image/ClF8rLe", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer new" {
+			w.WriteHeader(401)
+			fmt.Fprint(w, `{"data":{"error":"The access token provided is invalid."},"success":false,"status":401}`)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"id":"ClF8rLe"},"success":true,"status":200}`)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestTokenSourceRefreshOnUnauthorized(t *testing.T) {
+	var refreshes int32
+	server := testRefreshServer(t, &refreshes)
+	defer server.Close()
+
+	var saved *imgur.Token
+	c := Config{ClientID: "client", ClientSecret: "secret", Endpoint: server.URL + "/oauth2", HTTPClient: server.Client()}
+	ts := c.TokenSource(&imgur.Token{AccessToken: "old", RefreshToken: "refresh", AccountUsername: "Locker"}, func(t *imgur.Token) { saved = t })
+	client, err := imgur.New("client", imgur.WithTokenSource(ts), imgur.WithHTTPClient(server.Client()), imgur.WithBaseURL(server.URL+"/3/"))
+	require.NoError(t, err)
+
+	img, status, err := client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Equal(t, imgur.ImageID("ClF8rLe"), img.ID)
+	require.EqualValues(t, 1, refreshes)
+
+	require.NotNil(t, saved)
+	require.Equal(t, "new", saved.AccessToken)
+	require.Equal(t, "refresh2", saved.RefreshToken)
+	require.Equal(t, "Locker", saved.AccountUsername)
+
+	// the new token is used right away
+	_, _, err = client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+	require.EqualValues(t, 1, refreshes)
+}
+
+func TestTokenSourceRefreshBeforeExpiry(t *testing.T) {
+	var refreshes int32
+	server := testRefreshServer(t, &refreshes)
+	defer server.Close()
+
+	c := Config{ClientID: "client", ClientSecret: "secret", Endpoint: server.URL + "/oauth2", HTTPClient: server.Client()}
+	ts := c.TokenSource(&imgur.Token{AccessToken: "old", RefreshToken: "refresh", Expiry: time.Now().Add(time.Second)}, nil)
+
+	token, err := ts.Token(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "new", token.AccessToken)
+	require.EqualValues(t, 1, refreshes)
+
+	// a stale token is not refreshed twice
+	token, err = ts.Refresh(context.Background(), &imgur.Token{AccessToken: "old"})
+	require.NoError(t, err)
+	require.Equal(t, "new", token.AccessToken)
+	require.EqualValues(t, 1, refreshes)
+}
+
+func TestTokenSourceRefreshOnce(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth2/token" {
+			fmt.Fprint(w, `{"access_token":"still invalid","expires_in":3600}`)
+			return
+		}
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(401)
+		fmt.Fprint(w, `{"data":{"error":"The access token provided is invalid."},"success":false,"status":401}`)
+	}))
+	defer server.Close()
+
+	c := Config{ClientID: "client", ClientSecret: "secret", Endpoint: server.URL + "/oauth2", HTTPClient: server.Client()}
+	ts := c.TokenSource(&imgur.Token{AccessToken: "old", RefreshToken: "refresh"}, nil)
+	client, err := imgur.New("client", imgur.WithTokenSource(ts), imgur.WithHTTPClient(server.Client()), imgur.WithBaseURL(server.URL+"/3/"))
+	require.NoError(t, err)
+
+	_, _, err = client.GetImageInfo("ClF8rLe")
+	require.True(t, errors.Is(err, imgur.ErrUnauthorized))
+	require.EqualValues(t, 2, requests)
+}
+
+func TestTokenSourceWithoutRefreshToken(t *testing.T) {
+	c := Config{ClientID: "client", ClientSecret: "secret"}
+	ts := c.TokenSource(&imgur.Token{AccessToken: "old", Expiry: time.Now().Add(-time.Hour)}, nil)
+	_, err := ts.Token(context.Background())
+	require.Error(t, err)
+}
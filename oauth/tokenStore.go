@@ -0,0 +1,107 @@
+package oauth
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/koffeinsource/go-imgur"
+)
+
+// ErrNoToken is returned by TokenStore.Load if no token was saved yet
+var ErrNoToken = errors.New("no token stored")
+
+// TokenStore persists a token across restarts.
+// Save can be passed to TokenSource to store every refreshed token:
+//
+//	ts := config.TokenSource(token, func(t *imgur.Token) { _ = store.Save(t) })
+type TokenStore interface {
+	// Load returns the saved token or ErrNoToken.
+	Load() (*imgur.Token, error)
+	// Save replaces the saved token with token.
+	Save(token *imgur.Token) error
+}
+
+// FileStore is a TokenStore that keeps the token in a file encrypted with AES-GCM.
+type FileStore struct {
+	path string
+	aead cipher.AEAD
+}
+
+// NewFileStore creates a store for the file at path. key has to be 16, 24 or 32
+// bytes long to select AES-128, AES-192 or AES-256.
+func NewFileStore(path string, key []byte) (*FileStore, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid key for token store - %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &FileStore{path: path, aead: aead}, nil
+}
+
+// Load reads and decrypts the token.
+func (s *FileStore) Load() (*imgur.Token, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNoToken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Could not read token file %v - %w", s.path, err)
+	}
+
+	size := s.aead.NonceSize()
+	if len(data) < size {
+		return nil, fmt.Errorf("Token file %v is corrupt", s.path)
+	}
+	plain, err := s.aead.Open(nil, data[:size], data[size:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("Could not decrypt token file %v - %w", s.path, err)
+	}
+
+	token := &imgur.Token{}
+	if err := json.Unmarshal(plain, token); err != nil {
+		return nil, fmt.Errorf("Problem decoding token file %v - %w", s.path, err)
+	}
+	return token, nil
+}
+
+// Save encrypts token and replaces the file atomically. The file is only readable by the owner.
+func (s *FileStore) Save(token *imgur.Token) error {
+	plain, err := json.Marshal(token)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+	data := s.aead.Seal(nonce, nonce, plain, nil)
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return fmt.Errorf("Could not create token file - %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	// CreateTemp already creates the file with mode 0600
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("Could not write token file - %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("Could not write token file - %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("Could not replace token file %v - %w", s.path, err)
+	}
+	return nil
+}
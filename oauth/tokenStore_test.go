@@ -0,0 +1,52 @@
+package oauth
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/koffeinsource/go-imgur"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	key := bytes.Repeat([]byte{7}, 32)
+	store, err := NewFileStore(path, key)
+	require.NoError(t, err)
+
+	_, err = store.Load()
+	require.True(t, errors.Is(err, ErrNoToken))
+
+	token := &imgur.Token{AccessToken: "access", RefreshToken: "refresh", Expiry: time.Now().Add(time.Hour).Round(0), AccountUsername: "Locker"}
+	require.NoError(t, store.Save(token))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(data, []byte("refresh")))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	loaded, err := store.Load()
+	require.NoError(t, err)
+	require.Equal(t, token.AccessToken, loaded.AccessToken)
+	require.Equal(t, token.RefreshToken, loaded.RefreshToken)
+	require.Equal(t, token.AccountUsername, loaded.AccountUsername)
+	require.True(t, token.Expiry.Equal(loaded.Expiry))
+
+	// a store with another key can not read the token
+	other, err := NewFileStore(path, bytes.Repeat([]byte{8}, 32))
+	require.NoError(t, err)
+	_, err = other.Load()
+	require.Error(t, err)
+}
+
+func TestFileStoreInvalidKey(t *testing.T) {
+	_, err := NewFileStore("token", []byte("short"))
+	require.Error(t, err)
+}
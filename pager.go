@@ -0,0 +1,76 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// pager walks through the pages of a listing until imgur returns an empty page. The items
+// of a page are decoded one by one as they are read from the response, see listStream.
+// The typed iterators embed it and keep the current item.
+type pager struct {
+	client *Client
+	path   func(page int) (string, error) // the path of a page of the listing
+	params url.Values
+	what   string // names the listing in errors
+
+	page   int         // page of stream
+	stream *listStream // nil until a page is requested and after it was read
+	items  int         // number of items read from the page
+	done   bool
+	err    error
+}
+
+// next decodes the next item into v, requesting the next page if needed. The page is
+// requested with the ctx of the call that needs it.
+func (p *pager) next(ctx context.Context, v interface{}) bool {
+	for p.err == nil && !p.done {
+		if p.stream == nil {
+			path, err := p.path(p.page)
+			if err != nil {
+				p.err = err
+				return false
+			}
+			stream, err := p.client.openList(ctx, path, p.params)
+			if err != nil {
+				p.err = fmt.Errorf("Problem getting %v - %w", p.what, err)
+				return false
+			}
+			p.stream, p.items = stream, 0
+		}
+
+		ok, err := p.stream.next(v)
+		if err != nil {
+			p.stream.close()
+			p.stream = nil
+			p.err = fmt.Errorf("Problem getting %v - %w", p.what, err)
+			return false
+		}
+		if ok {
+			p.items++
+			return true
+		}
+		p.stream = nil
+		p.page++
+		p.done = p.items == 0
+	}
+	return false
+}
+
+// Err returns the error that stopped the iteration, nil if all items were read
+func (p *pager) Err() error {
+	return p.err
+}
+
+// Close releases the response of the current page if the iteration is stopped before all
+// items were read. Further calls of Next report no items.
+func (p *pager) Close() error {
+	p.done = true
+	if p.stream == nil {
+		return nil
+	}
+	err := p.stream.close()
+	p.stream = nil
+	return err
+}
@@ -0,0 +1,55 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Processing states reported by imgur after a video upload
+const (
+	ProcessingPending   = "pending"
+	ProcessingCompleted = "completed"
+	ProcessingFailed    = "failed"
+)
+
+// Processing describes the conversion imgur runs on uploaded videos and gifs.
+type Processing struct {
+	Status string `json:"status"` // pending, completed or failed
+}
+
+// delays used while polling the processing state, the delay doubles after every poll
+var (
+	processingPollDelay    = 2 * time.Second
+	processingMaxPollDelay = 30 * time.Second
+)
+
+// WaitForProcessing polls imgur until the conversion of an uploaded video or gif
+// is done. Images without a processing state are considered completed.
+// returns image info, status code of the last request, error
+func (client *Client) WaitForProcessing(ctx context.Context, imageID ImageID) (*ImageInfo, int, error) {
+	delay := processingPollDelay
+	for {
+		img, status, err := client.GetImageInfoWithContext(ctx, imageID)
+		if err != nil {
+			return nil, status, err
+		}
+
+		if img.Processing == nil || img.Processing.Status == ProcessingCompleted {
+			return img, status, nil
+		}
+		if img.Processing.Status == ProcessingFailed {
+			return img, status, fmt.Errorf("Processing of imageID %v failed", imageID)
+		}
+
+		client.Log.Debugf("imageID %v is still processing (%v), checking again in %v", imageID, img.Processing.Status, delay)
+		if err := sleepContext(ctx, delay); err != nil {
+			return nil, -1, err
+		}
+
+		delay *= 2
+		if delay > processingMaxPollDelay {
+			delay = processingMaxPollDelay
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func fastProcessingPolls(t *testing.T) {
+	delay, maxDelay := processingPollDelay, processingMaxPollDelay
+	processingPollDelay, processingMaxPollDelay = time.Millisecond, 2*time.Millisecond
+	t.Cleanup(func() {
+		processingPollDelay, processingMaxPollDelay = delay, maxDelay
+	})
+}
+
+func TestWaitForProcessingSimulated(t *testing.T) {
+	fastProcessingPolls(t)
+
+	var requests int
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/3/image/xZ3bTd2", r.URL.Path)
+		requests++
+		state := ProcessingPending
+		if requests == 3 {
+			state = ProcessingCompleted
+		}
+		fmt.Fprintf(w, `{"data":{"id":"xZ3bTd2","mp4":"https://i.imgur.com/xZ3bTd2.mp4","processing":{"status":"%v"}},"success":true,"status":200}`, state)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	img, status, err := client.WaitForProcessing(context.Background(), "xZ3bTd2")
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Equal(t, 3, requests)
+	require.Equal(t, ProcessingCompleted, img.Processing.Status)
+}
+
+func TestWaitForProcessingFailed(t *testing.T) {
+	fastProcessingPolls(t)
+
+	httpC, server := testHTTPClientJSON(`{"data":{"id":"xZ3bTd2","processing":{"status":"failed"}},"success":true,"status":200}`)
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	_, _, err := client.WaitForProcessing(context.Background(), "xZ3bTd2")
+	require.Error(t, err)
+}
+
+func TestWaitForProcessingCanceled(t *testing.T) {
+	httpC, server := testHTTPClientJSON(`{"data":{"id":"xZ3bTd2","processing":{"status":"pending"}},"success":true,"status":200}`)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	client, _ := NewClient(httpC, "testing", "")
+	_, _, err := client.WaitForProcessing(ctx, "xZ3bTd2")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
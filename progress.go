@@ -0,0 +1,32 @@
+package imgur
+
+import "io"
+
+// ProgressFunc is called while an upload is sent to imgur or a download is received.
+// sent is the number of bytes transferred so far, total is the size of the file or -1
+// if it is not known.
+type ProgressFunc func(sent, total int64)
+
+// progressReader reports every read to a ProgressFunc
+type progressReader struct {
+	r        io.Reader
+	sent     int64
+	total    int64
+	progress ProgressFunc
+}
+
+func newProgressReader(r io.Reader, total int64, progress ProgressFunc) io.Reader {
+	if progress == nil {
+		return r
+	}
+	return &progressReader{r: r, total: total, progress: progress}
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.sent += int64(n)
+		p.progress(p.sent, p.total)
+	}
+	return n, err
+}
@@ -0,0 +1,52 @@
+package imgur
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithProxy(t *testing.T) {
+	var requests int
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		// a proxy gets the absolute URL of the request
+		require.Equal(t, "http://api.example.com/3/image/ClF8rLe", r.URL.String())
+		require.Equal(t, "Client-ID testing", r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"data":{"id":"ClF8rLe"},"success":true,"status":200}`)
+	}))
+	defer proxy.Close()
+
+	proxyURL, _ := url.Parse(proxy.URL)
+	httpC := &http.Client{}
+	client, err := New("testing", WithHTTPClient(httpC), WithBaseURL("http://api.example.com/3/"), WithProxy(proxyURL))
+	require.NoError(t, err)
+	img, _, err := client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+	require.Equal(t, ImageID("ClF8rLe"), img.ID)
+	require.Equal(t, 1, requests)
+	// the http.Client of the application is not changed
+	require.Nil(t, httpC.Transport)
+}
+
+func TestWithProxyDirect(t *testing.T) {
+	t.Setenv("HTTPS_PROXY", "http://proxy.example.com:3128")
+	client, err := New("testing", WithProxy(nil))
+	require.NoError(t, err)
+
+	req, _ := http.NewRequest("GET", "https://api.imgur.com/3/image/ClF8rLe", nil)
+	proxyURL, err := client.httpClient.Transport.(*compressionTransport).next.(*http.Transport).Proxy(req)
+	require.NoError(t, err)
+	require.Nil(t, proxyURL)
+}
+
+func TestWithProxyCustomTransport(t *testing.T) {
+	proxyURL, _ := url.Parse("http://proxy.example.com:3128")
+	httpC := &http.Client{Transport: roundTripperFunc(http.DefaultTransport.RoundTrip)}
+	_, err := New("testing", WithHTTPClient(httpC), WithProxy(proxyURL))
+	require.Error(t, err)
+}
@@ -1,8 +1,9 @@
 package imgur
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -36,6 +37,38 @@ type RateLimit struct {
 	ClientLimit int64
 	// Total credits remaining for the application in a day.
 	ClientRemaining int64
+	// Total POST requests, e.g. uploads, that can be made until PostReset.
+	PostLimit int64
+	// POST requests remaining until PostReset.
+	PostRemaining int64
+	// Timestamp for when the POST rate limit will be reset.
+	PostReset time.Time
+	// Total requests the RapidAPI plan allows until RapidAPIReset, 0 without RapidAPI.
+	RapidAPILimit int64
+	// Requests of the RapidAPI plan remaining until RapidAPIReset.
+	RapidAPIRemaining int64
+	// Timestamp for when the requests of the RapidAPI plan will be reset.
+	RapidAPIReset time.Time
+}
+
+// hasRateLimits reports if h carries any rate limit headers
+func hasRateLimits(h http.Header) bool {
+	return hasCreditRateLimits(h) || hasPostRateLimits(h) || hasRapidAPIRateLimits(h)
+}
+
+// hasCreditRateLimits reports if h carries the headers of the user or client credits
+func hasCreditRateLimits(h http.Header) bool {
+	return h.Get("X-RateLimit-UserLimit") != "" || h.Get("X-RateLimit-ClientLimit") != ""
+}
+
+// hasPostRateLimits reports if h carries the headers of the POST rate limit
+func hasPostRateLimits(h http.Header) bool {
+	return h.Get("X-Post-Rate-Limit-Limit") != ""
+}
+
+// hasRapidAPIRateLimits reports if h carries the headers RapidAPI adds for the requests of the plan
+func hasRapidAPIRateLimits(h http.Header) bool {
+	return h.Get("X-RateLimit-Requests-Limit") != ""
 }
 
 func extractRateLimits(h http.Header) (rl *RateLimit, err error) {
@@ -70,16 +103,58 @@ func extractRateLimits(h http.Header) (rl *RateLimit, err error) {
 		rl.ClientRemaining, err = strconv.ParseInt(clientRemainingStr, 10, 32)
 	}
 
+	postLimitStr := h.Get("X-Post-Rate-Limit-Limit")
+	if postLimitStr != "" {
+		rl.PostLimit, err = strconv.ParseInt(postLimitStr, 10, 32)
+	}
+
+	postRemainingStr := h.Get("X-Post-Rate-Limit-Remaining")
+	if postRemainingStr != "" {
+		rl.PostRemaining, err = strconv.ParseInt(postRemainingStr, 10, 32)
+	}
+
+	// unlike the user reset, the POST reset is given in seconds from now
+	postResetStr := h.Get("X-Post-Rate-Limit-Reset")
+	if postResetStr != "" {
+		var postReset int64
+		postReset, err = strconv.ParseInt(postResetStr, 10, 64)
+		rl.PostReset = time.Now().Add(time.Duration(postReset) * time.Second)
+	}
+
+	rapidLimitStr := h.Get("X-RateLimit-Requests-Limit")
+	if rapidLimitStr != "" {
+		rl.RapidAPILimit, err = strconv.ParseInt(rapidLimitStr, 10, 64)
+	}
+
+	rapidRemainingStr := h.Get("X-RateLimit-Requests-Remaining")
+	if rapidRemainingStr != "" {
+		rl.RapidAPIRemaining, err = strconv.ParseInt(rapidRemainingStr, 10, 64)
+	}
+
+	// like the POST reset, the RapidAPI reset is given in seconds from now
+	rapidResetStr := h.Get("X-RateLimit-Requests-Reset")
+	if rapidResetStr != "" {
+		var rapidReset int64
+		rapidReset, err = strconv.ParseInt(rapidResetStr, 10, 64)
+		rl.RapidAPIReset = time.Now().Add(time.Duration(rapidReset) * time.Second)
+	}
+
 	return
 }
 
 // GetRateLimit returns the current rate limit without doing anything else
 func (client *Client) GetRateLimit() (*RateLimit, error) {
+	return client.GetRateLimitWithContext(context.Background())
+}
+
+// GetRateLimitWithContext is like GetRateLimit, but the request is bound to ctx
+func (client *Client) GetRateLimitWithContext(ctx context.Context) (*RateLimit, error) {
 	// We are requesting any URL and parse the returned HTTP headers
-	body, rl, err := client.getURL("account/kaffeeshare")
+	path := "account/kaffeeshare"
+	body, rl, err := client.getURL(ctx, path)
 
 	if err != nil {
-		return nil, errors.New("Problem getting URL for rate - " + err.Error())
+		return nil, fmt.Errorf("Problem getting URL for rate - %w", err)
 	}
 	//client.Log.Debugf("%v\n", body)
 
@@ -87,11 +162,11 @@ func (client *Client) GetRateLimit() (*RateLimit, error) {
 
 	var bodyDecoded rateLimitDataWrapper
 	if err := dec.Decode(&bodyDecoded); err != nil {
-		return nil, errors.New("Problem decoding json for ratelimit - " + err.Error())
+		return nil, fmt.Errorf("Problem decoding json for ratelimit - %w", err)
 	}
 
 	if !bodyDecoded.Success {
-		return nil, errors.New("Request to imgur failed for ratelimit - " + strconv.Itoa(bodyDecoded.Status))
+		return nil, fmt.Errorf("Request to imgur failed for ratelimit - %w", NewAPIError("GET", client.createAPIURL(path), bodyDecoded.Status, []byte(body)))
 	}
 
 	var ret RateLimit
@@ -100,6 +175,31 @@ func (client *Client) GetRateLimit() (*RateLimit, error) {
 	ret.UserLimit = rl.UserLimit
 	ret.UserRemaining = rl.UserRemaining
 	ret.UserReset = rl.UserReset
+	ret.PostLimit = rl.PostLimit
+	ret.PostRemaining = rl.PostRemaining
+	ret.PostReset = rl.PostReset
+	ret.RapidAPILimit = rl.RapidAPILimit
+	ret.RapidAPIRemaining = rl.RapidAPIRemaining
+	ret.RapidAPIReset = rl.RapidAPIReset
 
 	return &ret, nil
 }
+
+// GetCredits queries imgur for the credits of the client and user with GET /credits.
+// Unlike GetRateLimit the values are taken from the response body, so they are
+// available even if a proxy strips the rate limit headers.
+// returns the credits, status code of the request, error
+func (client *Client) GetCredits(ctx context.Context) (*RateLimit, int, error) {
+	var credits rateLimitInternal
+	_, status, err := client.send(ctx, "GET", "credits", nil, &credits)
+	if err != nil {
+		return nil, status, fmt.Errorf("Problem getting credits - %w", err)
+	}
+	return &RateLimit{
+		UserLimit:       credits.UserLimit,
+		UserRemaining:   credits.UserRemaining,
+		UserReset:       time.Unix(credits.UserReset, 0),
+		ClientLimit:     credits.ClientLimit,
+		ClientRemaining: credits.ClientRemaining,
+	}, status, nil
+}
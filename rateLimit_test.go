@@ -1,9 +1,14 @@
 package imgur
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 	"os"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestRateLimitImgurSimulated(t *testing.T) {
@@ -71,3 +76,21 @@ func TestRateLimitRealImgur(t *testing.T) {
 		t.Error("Client/User limits are wrong. Probably something broken. Or IMGUR changed their limits. Or you are not using a free account for testing. Sorry. No real good way to test this.")
 	}
 }
+
+func TestGetCredits(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/3/credits", r.URL.Path)
+		fmt.Fprint(w, `{"data":{"UserLimit":2000,"UserRemaining":1999,"UserReset":1460715031,"ClientLimit":12500,"ClientRemaining":12400},"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	credits, status, err := client.GetCredits(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Equal(t, int64(2000), credits.UserLimit)
+	require.Equal(t, int64(1999), credits.UserRemaining)
+	require.Equal(t, int64(12500), credits.ClientLimit)
+	require.Equal(t, int64(12400), credits.ClientRemaining)
+	require.Equal(t, time.Unix(1460715031, 0), credits.UserReset)
+}
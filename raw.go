@@ -0,0 +1,80 @@
+package imgur
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// WithRawJSON keeps the JSON imgur sent in the Raw field of ImageInfo, AlbumInfo,
+// GalleryImageInfo, GalleryAlbumInfo, Account and Comment. It allows reading fields
+// imgur added that this package does not decode yet. The items of listings, like the
+// images of GetAccountImages or the items of the iterators, keep their own JSON.
+func WithRawJSON() ClientOption {
+	return func(c *Client) {
+		c.rawJSON = true
+	}
+}
+
+// rawKeeper is implemented by the types that can keep the raw JSON of a response
+type rawKeeper interface {
+	setRaw(raw json.RawMessage)
+}
+
+func (ii *ImageInfo) setRaw(raw json.RawMessage)        { ii.Raw = raw }
+func (ai *AlbumInfo) setRaw(raw json.RawMessage)        { ai.Raw = raw }
+func (gi *GalleryImageInfo) setRaw(raw json.RawMessage) { gi.Raw = raw }
+func (ga *GalleryAlbumInfo) setRaw(raw json.RawMessage) { ga.Raw = raw }
+func (a *Account) setRaw(raw json.RawMessage)           { a.Raw = raw }
+func (c *Comment) setRaw(raw json.RawMessage)           { c.Raw = raw }
+
+func (item *GalleryItem) setRaw(raw json.RawMessage) {
+	if item.album != nil {
+		item.album.Raw = raw
+	} else if item.image != nil {
+		item.image.Raw = raw
+	}
+}
+
+// keepRawJSON stores the data of the response body in v if WithRawJSON is set
+func (client *Client) keepRawJSON(body []byte, v interface{}) {
+	if !client.rawJSON {
+		return
+	}
+	var wrapper struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &wrapper); err != nil {
+		client.Log.Infof("Problem keeping the JSON of the response: %v", err)
+		return
+	}
+	if err := setRawJSON(wrapper.Data, v); err != nil {
+		client.Log.Infof("Problem keeping the JSON of the response: %v", err)
+	}
+}
+
+// setRawJSON stores data in v, or the items of the JSON array data in the items of the
+// slice v points to
+func setRawJSON(data json.RawMessage, v interface{}) error {
+	if keeper, ok := v.(rawKeeper); ok {
+		keeper.setRaw(data)
+		return nil
+	}
+	items, ok := sliceTarget(v)
+	if !ok {
+		return nil
+	}
+	var raws []json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return err
+	}
+	for i := 0; i < len(raws) && i < items.Len(); i++ {
+		item := items.Index(i)
+		if item.Kind() != reflect.Ptr {
+			item = item.Addr()
+		}
+		if keeper, ok := item.Interface().(rawKeeper); ok && !item.IsNil() {
+			keeper.setRaw(raws[i])
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package imgur
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRawJSON(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/3/image/ClF8rLe":
+			fmt.Fprint(w, `{"data":{"id":"ClF8rLe","new_field":"surprise"},"success":true,"status":200}`)
+		case "/3/account/Locker":
+			fmt.Fprint(w, `{"data":{"id":42,"url":"Locker","new_field":3},"success":true,"status":200}`)
+		}
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithRawJSON())
+	img, _, err := client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+	var extra struct {
+		NewField string `json:"new_field"`
+	}
+	require.NoError(t, json.Unmarshal(img.Raw, &extra))
+	require.Equal(t, "surprise", extra.NewField)
+
+	account, _, err := client.GetAccount(context.Background(), "Locker")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id":42,"url":"Locker","new_field":3}`, string(account.Raw))
+
+	plain, _ := NewClient(httpC, "testing", "")
+	img, _, err = plain.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+	require.Nil(t, img.Raw)
+}
+
+func TestWithRawJSONListings(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/3/account/Locker/images/0":
+			fmt.Fprint(w, `{"data":[{"id":"ClF8rLe","new_field":1},{"id":"asd","new_field":2}],"success":true,"status":200}`)
+		case "/3/account/Locker/images/1":
+			fmt.Fprint(w, `{"data":[],"success":true,"status":200}`)
+		case "/3/gallery/hot/viral/day/0":
+			fmt.Fprint(w, `{"data":[{"id":"ClF8rLe","is_album":false,"new_field":1},{"id":"VZQXk","is_album":true,"new_field":2}],"success":true,"status":200}`)
+		default:
+			t.Errorf("Unexpected request %v", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithRawJSON())
+	images, _, err := client.GetAccountImages(context.Background(), "Locker", 0)
+	require.NoError(t, err)
+	require.Len(t, images, 2)
+	require.JSONEq(t, `{"id":"ClF8rLe","new_field":1}`, string(images[0].Raw))
+	require.JSONEq(t, `{"id":"asd","new_field":2}`, string(images[1].Raw))
+
+	items, _, err := client.GetGallery(context.Background(), SectionHot, SortViral, WindowDay, 0, true, false)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	require.JSONEq(t, `{"id":"ClF8rLe","is_album":false,"new_field":1}`, string(items[0].AsImage().Raw))
+	require.JSONEq(t, `{"id":"VZQXk","is_album":true,"new_field":2}`, string(items[1].AsAlbum().Raw))
+
+	// the iterators keep the JSON of every item
+	it := client.AccountImages("Locker")
+	defer it.Close()
+	var raws []string
+	for it.Next(context.Background()) {
+		raws = append(raws, string(it.Image().Raw))
+	}
+	require.NoError(t, it.Err())
+	require.Equal(t, []string{`{"id":"ClF8rLe","new_field":1}`, `{"id":"asd","new_field":2}`}, raws)
+}
@@ -0,0 +1,140 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// retry sends req and retries it according to the retry policy that applies to it.
+// Requests with a body are only retried if the body can be recreated with req.GetBody.
+// With a token source, a request rejected with 401 is sent again once with a refreshed token.
+// attempts counts how often the request was sent.
+func (client *Client) retry(req *http.Request, attempts *int) (*http.Response, error) {
+	ctx := req.Context()
+	policy := client.retryPolicyFor(ctx)
+	req.Header.Set("User-Agent", client.userAgent)
+
+	var token *Token
+	if client.tokenSource != nil && !isAnonymous(ctx) {
+		var err error
+		if token, err = client.tokenSource.Token(ctx); err != nil {
+			return nil, &tokenError{fmt.Errorf("Could not get access token - %w", err)}
+		}
+		req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	}
+	refreshed := false
+
+	for attempt := 1; ; attempt++ {
+		wait, err := client.throttle(req)
+		if err != nil {
+			return nil, err
+		}
+		if wait > 0 {
+			client.Log.Infof("Rate limit is exhausted, waiting %v before requesting %v", wait, req.URL)
+			if err := sleepContext(ctx, wait); err != nil {
+				return nil, err
+			}
+		}
+
+		probe, err := client.breaker.allow()
+		if err != nil {
+			return nil, err
+		}
+		*attempts++
+		sent, cancel := withTimeout(req, client.requestTimeoutFor(req))
+		res, err := client.httpClient.Do(sent)
+		if err == nil {
+			client.updateRateLimits(res)
+		}
+		client.recordOutcome(req, res, err, probe)
+
+		canReplay := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+		if err == nil && res.StatusCode == http.StatusUnauthorized && token != nil && !refreshed && canReplay {
+			client.Log.Infof("Access token was rejected for %v, refreshing it", req.URL)
+			refreshed = true
+			discardBody(res)
+			cancel()
+			if token, err = client.tokenSource.Refresh(ctx, token); err != nil {
+				return nil, &tokenError{fmt.Errorf("Could not refresh access token - %w", err)}
+			}
+			if req, err = replayRequest(req); err != nil {
+				return nil, err
+			}
+			req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+			// the refresh does not count as an attempt
+			attempt--
+			continue
+		}
+
+		if attempt >= policy.MaxAttempts || !canReplay || !shouldRetry(req, res, err) {
+			if err != nil {
+				cancel()
+				return res, err
+			}
+			// the timeout covers reading the response as well
+			res.Body = &cancelBody{ReadCloser: res.Body, cancel: cancel}
+			return res, nil
+		}
+
+		delay := policy.delay(attempt, res)
+		if err != nil {
+			client.Log.Infof("Request to %v failed (%v), retrying in %v", req.URL, err, delay)
+		} else {
+			client.Log.Infof("Request to %v failed with %v, retrying in %v", req.URL, res.Status, delay)
+			discardBody(res)
+		}
+		cancel()
+
+		if err := sleepContext(ctx, delay); err != nil {
+			return nil, err
+		}
+
+		if req, err = replayRequest(req); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// tokenError is returned by retry if the token source failed to provide an access token
+type tokenError struct {
+	err error
+}
+
+func (e *tokenError) Error() string { return e.err.Error() }
+func (e *tokenError) Unwrap() error { return e.err }
+
+// replayRequest prepares req to be sent again, recreating its body with req.GetBody
+func replayRequest(req *http.Request) (*http.Request, error) {
+	if req.GetBody == nil {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Body = body
+	return req, nil
+}
+
+// discardBody drains and closes the body of res so the connection can be reused
+func discardBody(res *http.Response) {
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(res.Body, 4096))
+	res.Body.Close()
+}
+
+// sleepContext waits for d or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,154 @@
+package imgur
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Cache stores the responses of imgur for a while, see WithCache.
+// Implementations have to be safe for concurrent use. A cache that fails, e.g. because
+// its server is down, should treat it like a miss, so requests are sent to imgur.
+type Cache interface {
+	// Get returns the value stored for key, false if there is none or it expired
+	Get(ctx context.Context, key string) ([]byte, bool)
+	// Set stores value for key, it expires after ttl
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration)
+}
+
+// WithCache stores the responses of image, album and gallery lookups in cache for ttl, e.g.
+// of GetImageInfo, GetAlbumInfo and GetGalleryImageInfo. While a response is cached no request
+// is sent, so no credits are used and the call hooks are not called. Cached responses carry
+// no rate limits. Changes made through the client are visible once the responses expired.
+// The keys of the cache are derived from the URL and the credentials of a request,
+// they do not contain the credentials.
+func WithCache(cache Cache, ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		c.cache = cache
+		c.cacheTTL = ttl
+	}
+}
+
+// cachedEndpoints are the endpoints whose responses are stored by WithCache
+var cachedEndpoints = map[string]bool{
+	"image/{id}":                                   true,
+	"album/{id}":                                   true,
+	"album/{id}/images":                            true,
+	"gallery/image/{id}":                           true,
+	"gallery/album/{id}":                           true,
+	"gallery/{id}":                                 true,
+	"gallery/tag_info/{tag}":                       true,
+	"gallery/t/{tag}/{sort}/{page}":                true,
+	"gallery/{section}/{sort}/{window}/{page}":     true,
+	"gallery/search/{sort}/{window}/{page}":        true,
+	"gallery/r/{subreddit}/{sort}/{window}/{page}": true,
+	"gallery/r/{subreddit}/{id}":                   true,
+}
+
+// do sends req like observe, responses of the cached endpoints are taken from the cache
+func (client *Client) do(req *http.Request) (*http.Response, error) {
+	if client.cache == nil || req.Method != http.MethodGet || !cachedEndpoints[client.endpoint(req.URL.String())] {
+		return client.observe(req)
+	}
+
+	ctx := req.Context()
+	auth, err := client.authorization(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	key := cacheKeyFor(auth, req.URL.String())
+	if body, ok := client.cache.Get(ctx, key); ok {
+		client.Log.Debugf("Using cached response for %v", req.URL)
+		return &http.Response{
+			Status:        "200 OK",
+			StatusCode:    http.StatusOK,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{"Content-Type": {"application/json"}},
+			Body:          ioutil.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+
+	res, err := client.observe(req)
+	if err != nil || res.StatusCode != http.StatusOK {
+		return res, err
+	}
+	body, err := client.readBody(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("Problem reading the body for %v - %w", req.URL, err)
+	}
+	if !isErrorPage(body) {
+		client.cache.Set(ctx, key, body, client.cacheTTL)
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return res, nil
+}
+
+// authorization returns the Authorization header req will be sent with. The access token
+// of a token source is only set by retry, so it is requested here already.
+func (client *Client) authorization(ctx context.Context, req *http.Request) (string, error) {
+	if client.tokenSource == nil || isAnonymous(ctx) {
+		return req.Header.Get("Authorization"), nil
+	}
+	token, err := client.tokenSource.Token(ctx)
+	if err != nil {
+		return "", &tokenError{fmt.Errorf("Could not get access token - %w", err)}
+	}
+	return "Bearer " + token.AccessToken, nil
+}
+
+// MemoryCache is a Cache keeping the most recently used values in memory
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries *lru // of memoryCacheEntry
+}
+
+type memoryCacheEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryCache creates a MemoryCache keeping up to maxEntries values,
+// maxEntries below 1 means no limit.
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{entries: newLRU(maxEntries)}
+}
+
+// Get returns the value stored for key if it did not expire
+func (c *MemoryCache) Get(ctx context.Context, key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries.get(key)
+	if !ok {
+		return nil, false
+	}
+	entry := e.(memoryCacheEntry)
+	if time.Now().After(entry.expires) {
+		c.entries.remove(key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// Set stores value for key and evicts the least recently used value if the cache is full
+func (c *MemoryCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries.set(key, memoryCacheEntry{value: value, expires: time.Now().Add(ttl)})
+}
+
+// Len returns the number of stored values, including expired ones not evicted yet
+func (c *MemoryCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.entries.len()
+}
@@ -0,0 +1,100 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithCache(t *testing.T) {
+	var requests int
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path == "/3/image/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"data":{"id":"ClF8rLe","title":"request %v"},"success":true,"status":200}`, requests)
+	})
+	defer server.Close()
+
+	cache := NewMemoryCache(10)
+	client, _ := NewClient(httpC, "testing", "", WithCache(cache, time.Hour))
+	for i := 0; i < 3; i++ {
+		img, _, err := client.GetImageInfo("ClF8rLe")
+		require.NoError(t, err)
+		require.Equal(t, "request 1", img.Title)
+	}
+	require.Equal(t, 1, requests)
+
+	// errors and other endpoints are not cached
+	for i := 0; i < 2; i++ {
+		_, _, err := client.GetImageInfo("missing")
+		require.ErrorIs(t, err, ErrNotFound)
+		_, err = client.GetRateLimit()
+		require.NoError(t, err)
+	}
+	require.Equal(t, 5, requests)
+	require.Equal(t, 1, cache.Len())
+}
+
+func TestMemoryCacheTTL(t *testing.T) {
+	ctx := context.Background()
+	cache := NewMemoryCache(2)
+	cache.Set(ctx, "a", []byte("1"), time.Hour)
+	cache.Set(ctx, "b", []byte("2"), -time.Second)
+
+	value, ok := cache.Get(ctx, "a")
+	require.True(t, ok)
+	require.Equal(t, "1", string(value))
+	_, ok = cache.Get(ctx, "b")
+	require.False(t, ok)
+	require.Equal(t, 1, cache.Len())
+
+	cache.Set(ctx, "c", []byte("3"), time.Hour)
+	cache.Set(ctx, "d", []byte("4"), time.Hour)
+	_, ok = cache.Get(ctx, "a")
+	require.False(t, ok)
+	require.Equal(t, 2, cache.Len())
+}
+
+// userSource is a TokenSource returning the access token of a user
+type userSource string
+
+func (s userSource) Token(ctx context.Context) (*Token, error) {
+	return &Token{AccessToken: string(s)}, nil
+}
+
+func (s userSource) Refresh(ctx context.Context, expired *Token) (*Token, error) {
+	return expired, nil
+}
+
+func TestCacheKeyedByTokenSource(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"data":{"id":"ClF8rLe","title":"%v"},"success":true,"status":200}`, r.Header.Get("Authorization"))
+	})
+	defer server.Close()
+
+	// the clients of two users of the same application share the cache
+	cache := NewMemoryCache(10)
+	alice, _ := NewClient(httpC, "testing", "", WithCache(cache, time.Hour), WithTokenSource(userSource("alice")))
+	bob, _ := NewClient(httpC, "testing", "", WithCache(cache, time.Hour), WithTokenSource(userSource("bob")))
+	for _, user := range []struct {
+		client *Client
+		title  string
+	}{{alice, "Bearer alice"}, {bob, "Bearer bob"}, {alice, "Bearer alice"}} {
+		img, _, err := user.client.GetImageInfo("ClF8rLe")
+		require.NoError(t, err)
+		require.Equal(t, user.title, img.Title)
+	}
+
+	// anonymous requests are sent with the client ID
+	img, _, err := alice.GetImageInfoWithContext(contextAnonymous(context.Background()), "ClF8rLe")
+	require.NoError(t, err)
+	require.Equal(t, "Client-ID testing", img.Title)
+	require.Equal(t, 3, cache.Len())
+}
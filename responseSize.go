@@ -0,0 +1,86 @@
+package imgur
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// DefaultMaxResponseSize is the default limit for the body of an API response
+const DefaultMaxResponseSize = 8 << 20
+
+// WithMaxResponseSize limits the bodies of API responses to n bytes, larger responses
+// fail with ErrResponseTooLarge. Values below 1 disable the limit. Default is
+// DefaultMaxResponseSize. Downloads of files are not limited.
+func WithMaxResponseSize(n int64) ClientOption {
+	return func(c *Client) {
+		c.maxResponseSize = n
+	}
+}
+
+// limitBody returns a reader of body failing with ErrResponseTooLarge after limit bytes,
+// body itself if limit is below 1
+func limitBody(body io.Reader, limit int64) io.Reader {
+	if limit < 1 {
+		return body
+	}
+	return &limitedReader{r: body, remaining: limit, limit: limit, err: ErrResponseTooLarge}
+}
+
+// limitedReader reads up to limit bytes of r and fails with err if r has more
+type limitedReader struct {
+	r         io.Reader
+	remaining int64
+	limit     int64
+	err       error
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.remaining < 0 {
+		return 0, fmt.Errorf("%w: more than %v bytes", l.err, l.limit)
+	}
+	// read one byte more than allowed to tell a body of exactly limit bytes from a larger one
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+	n, err := l.r.Read(p)
+	if int64(n) > l.remaining {
+		n = int(l.remaining)
+		l.remaining = -1
+		return n, fmt.Errorf("%w: more than %v bytes", l.err, l.limit)
+	}
+	l.remaining -= int64(n)
+	return n, err
+}
+
+// readBody reads the body of an API response up to the limit of the client
+func (client *Client) readBody(body io.Reader) ([]byte, error) {
+	return ioutil.ReadAll(limitBody(body, client.maxResponseSize))
+}
+
+// jsonBody returns a reader of body for a JSON decoder. If body does not start like JSON,
+// it returns the start of body, e.g. of an HTML error page, instead.
+func jsonBody(body io.Reader) (io.Reader, []byte, error) {
+	br := bufio.NewReader(body)
+	for {
+		b, err := br.ReadByte()
+		if err == io.EOF {
+			return br, nil, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		switch b {
+		case ' ', '\t', '\r', '\n':
+			continue
+		case '{', '[':
+			if err := br.UnreadByte(); err != nil {
+				return nil, nil, err
+			}
+			return br, nil, nil
+		}
+		page, _ := ioutil.ReadAll(io.LimitReader(br, maxErrorPageBody))
+		return nil, append([]byte{b}, page...), nil
+	}
+}
@@ -0,0 +1,94 @@
+package imgur
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxResponseSize(t *testing.T) {
+	title := strings.Repeat("x", 1000)
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"data":{"id":"ClF8rLe","title":"%v"},"success":true,"status":200}`, title)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithMaxResponseSize(500))
+	_, _, err := client.GetImageInfo("ClF8rLe")
+	require.ErrorIs(t, err, ErrResponseTooLarge)
+	_, _, err = client.GetCommentReplies(context.Background(), 1)
+	require.ErrorIs(t, err, ErrResponseTooLarge)
+
+	for _, limit := range []int64{0, 2000} {
+		client, _ = NewClient(httpC, "testing", "", WithMaxResponseSize(limit))
+		img, _, err := client.GetImageInfo("ClF8rLe")
+		require.NoError(t, err)
+		require.Equal(t, title, img.Title)
+	}
+}
+
+func TestLimitBody(t *testing.T) {
+	body, err := ioutil.ReadAll(limitBody(strings.NewReader("12345"), 5))
+	require.NoError(t, err)
+	require.Equal(t, "12345", string(body))
+
+	body, err = ioutil.ReadAll(limitBody(strings.NewReader("123456"), 5))
+	require.ErrorIs(t, err, ErrResponseTooLarge)
+	require.Equal(t, "12345", string(body))
+}
+
+func TestSendUnsuccessfulEnvelope(t *testing.T) {
+	httpC, server := testHTTPClientJSON(`{"data":{"error":"Authentication required"},"success":false,"status":401}`)
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	_, status, err := client.GetCommentReplies(context.Background(), 1)
+	require.Equal(t, 401, status)
+	require.ErrorIs(t, err, ErrUnauthorized)
+
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, "Authentication required", apiErr.Message)
+}
+
+func TestSendDecodesListings(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/3/gallery/hot":
+			fmt.Fprint(w, `{"data":[{"id":"ClF8rLe","is_album":false},{"id":"VZQXk","is_album":true}],"success":true,"status":200}`)
+		case "/3/gallery/empty":
+			fmt.Fprint(w, `{"success":true,"data":null,"status":200}`)
+		case "/3/gallery/broken":
+			fmt.Fprint(w, `{"data":[{"id":"ClF8rLe"},{"id":5}],"success":true,"status":200}`)
+		default:
+			fmt.Fprint(w, `{"data":{"error":"Over capacity","request":"/3/gallery/error"},"success":false,"status":503}`)
+		}
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithRetryPolicy(NoRetry))
+	items := []GalleryItem{{}}
+	_, status, err := client.Do(context.Background(), "GET", "gallery/hot", nil, &items)
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Len(t, items, 2)
+	require.Equal(t, ImageID("ClF8rLe"), items[0].AsImage().ID)
+	require.Equal(t, AlbumID("VZQXk"), items[1].AsAlbum().ID)
+
+	_, _, err = client.Do(context.Background(), "GET", "gallery/empty", nil, &items)
+	require.NoError(t, err)
+	_, _, err = client.Do(context.Background(), "GET", "gallery/broken", nil, &items)
+	require.Error(t, err)
+
+	_, status, err = client.Do(context.Background(), "GET", "gallery/error", nil, &items)
+	require.Equal(t, 503, status)
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, "Over capacity", apiErr.Message)
+}
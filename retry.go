@@ -0,0 +1,132 @@
+package imgur
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy describes how requests that failed with a 429 or 5xx status are retried.
+// Clients use DefaultRetryPolicy unless another policy is set with WithRetryPolicy.
+type RetryPolicy struct {
+	MaxAttempts int           // Total number of attempts per request, values below 2 disable retries
+	BaseDelay   time.Duration // Delay before the first retry, it doubles for every further retry
+	MaxDelay    time.Duration // Upper bound for a single delay, 0 means no bound
+	Jitter      float64       // Fraction (0 to 1) of every delay that is randomized
+}
+
+// DefaultRetryPolicy is a reasonable retry policy for most applications.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    10 * time.Second,
+	Jitter:      0.2,
+}
+
+// NoRetry disables retries, e.g. for a single request with ContextWithRetryPolicy.
+var NoRetry = RetryPolicy{MaxAttempts: 1}
+
+// WithRetryPolicy sets the retry policy applied to all requests of the client instead of
+// DefaultRetryPolicy. WithRetryPolicy(NoRetry) disables retries.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+type retryPolicyKey struct{}
+
+// ContextWithRetryPolicy overrides the retry policy of the client for all requests bound to ctx.
+func ContextWithRetryPolicy(ctx context.Context, policy RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyKey{}, policy)
+}
+
+// retryPolicyFor returns the policy that applies to a request bound to ctx
+func (client *Client) retryPolicyFor(ctx context.Context) RetryPolicy {
+	if policy, ok := ctx.Value(retryPolicyKey{}).(RetryPolicy); ok {
+		return policy
+	}
+	return client.retryPolicy
+}
+
+// shouldRetry reports if a request failing with res and err is worth another attempt.
+// Transport errors are only retried for idempotent methods as other requests might have been
+// processed. Successful responses with an HTML page are retried for idempotent methods too,
+// imgur sends them when it is over capacity. A 5xx status is only retried for them as well, as
+// imgur might have created the upload, album or comment of a POST before failing. A POST is
+// retried after a 429, or a 503 with Retry-After, which are sent before the request is processed.
+func shouldRetry(req *http.Request, res *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if err != nil {
+		return isIdempotent(req.Method)
+	}
+	if res.StatusCode < 300 && strings.HasPrefix(res.Header.Get("Content-Type"), "text/html") {
+		return isIdempotent(req.Method)
+	}
+	switch {
+	case res.StatusCode == http.StatusTooManyRequests:
+		return true
+	case res.StatusCode == http.StatusServiceUnavailable && res.Header.Get("Retry-After") != "":
+		return true
+	case res.StatusCode >= 500:
+		return isIdempotent(req.Method)
+	}
+	return false
+}
+
+// isIdempotent reports if sending a request with method twice has the same effect as once
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// delay returns how long to wait before the given retry, starting with 1.
+// A Retry-After header of res takes precedence over the computed backoff.
+func (p RetryPolicy) delay(retry int, res *http.Response) time.Duration {
+	if res != nil {
+		if d, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			return d
+		}
+	}
+
+	d := p.BaseDelay
+	for i := 1; i < retry; i++ {
+		d *= 2
+		if p.MaxDelay > 0 && d > p.MaxDelay {
+			break
+		}
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	if p.Jitter > 0 {
+		d += time.Duration(p.Jitter * float64(d) * (rand.Float64()*2 - 1))
+	}
+	return d
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
@@ -0,0 +1,202 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testRetryPolicy = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+// testHTTPClientFlaky fails the first failures requests with status
+func testHTTPClientFlaky(t *testing.T, failures int, status int, requests *int) (*http.Client, func()) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		if r.Method == "POST" {
+			require.Contains(t, string(body), "retried body")
+		}
+
+		if *requests <= failures {
+			// imgur sends Retry-After when it is over capacity
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(status)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"id":"ClF8rLe"},"success":true,"status":200}`)
+	})
+	return httpC, server.Close
+}
+
+func TestRetryOnServerErrors(t *testing.T) {
+	for _, status := range []int{http.StatusTooManyRequests, http.StatusServiceUnavailable} {
+		var requests int
+		httpC, closeServer := testHTTPClientFlaky(t, 2, status, &requests)
+
+		client, _ := NewClient(httpC, "testing", "", WithRetryPolicy(testRetryPolicy))
+		img, _, err := client.GetImageInfo("ClF8rLe")
+		require.NoError(t, err)
+		require.Equal(t, ImageID("ClF8rLe"), img.ID)
+		require.Equal(t, 3, requests)
+		closeServer()
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	var requests int
+	httpC, closeServer := testHTTPClientFlaky(t, 5, http.StatusBadGateway, &requests)
+	defer closeServer()
+
+	client, _ := NewClient(httpC, "testing", "", WithRetryPolicy(testRetryPolicy))
+	_, _, err := client.GetImageInfo("ClF8rLe")
+	require.Error(t, err)
+	require.Equal(t, 3, requests)
+}
+
+func TestRetryNotOnClientErrors(t *testing.T) {
+	var requests int
+	httpC, closeServer := testHTTPClientFlaky(t, 5, http.StatusNotFound, &requests)
+	defer closeServer()
+
+	client, _ := NewClient(httpC, "testing", "", WithRetryPolicy(testRetryPolicy))
+	_, _, err := client.GetImageInfo("ClF8rLe")
+	require.Error(t, err)
+	require.Equal(t, 1, requests)
+}
+
+func TestRetryByDefault(t *testing.T) {
+	client, _ := New("testing")
+	require.Equal(t, DefaultRetryPolicy, client.retryPolicy)
+
+	client, _ = New("testing", WithRetryPolicy(NoRetry))
+	require.Equal(t, NoRetry, client.retryPolicy)
+}
+
+func TestRetryDisabled(t *testing.T) {
+	var requests int
+	httpC, closeServer := testHTTPClientFlaky(t, 1, http.StatusServiceUnavailable, &requests)
+	defer closeServer()
+
+	client, _ := NewClient(httpC, "testing", "", WithRetryPolicy(NoRetry))
+	_, _, err := client.GetImageInfo("ClF8rLe")
+	require.Error(t, err)
+	require.Equal(t, 1, requests)
+
+	requests = 0
+	client, _ = NewClient(httpC, "testing", "", WithRetryPolicy(testRetryPolicy))
+	ctx := ContextWithRetryPolicy(context.Background(), NoRetry)
+	_, _, err = client.GetImageInfoWithContext(ctx, "ClF8rLe")
+	require.Error(t, err)
+	require.Equal(t, 1, requests)
+}
+
+func TestRetryUploads(t *testing.T) {
+	var requests int
+	httpC, closeServer := testHTTPClientFlaky(t, 1, http.StatusServiceUnavailable, &requests)
+	defer closeServer()
+
+	client, _ := NewClient(httpC, "testing", "", WithRetryPolicy(testRetryPolicy))
+	_, _, err := client.Upload(context.Background(), BytesSource([]byte("retried body")))
+	require.NoError(t, err)
+	require.Equal(t, 2, requests)
+
+	// a plain reader can not be read a second time
+	requests = 0
+	_, _, err = client.Upload(context.Background(), ReaderSource(strings.NewReader("retried body"), -1))
+	require.Error(t, err)
+	require.Equal(t, 1, requests)
+
+	requests = 0
+	_, _, err = client.Upload(context.Background(), URLSource("https://example.com/retried body"))
+	require.NoError(t, err)
+	require.Equal(t, 2, requests)
+}
+
+func TestRetryNotOnPostServerErrors(t *testing.T) {
+	var requests int
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	defer server.Close()
+
+	// imgur might have stored the image before failing
+	client, _ := NewClient(httpC, "testing", "", WithRetryPolicy(testRetryPolicy))
+	_, status, err := client.Upload(context.Background(), BytesSource([]byte("retried body")))
+	require.Error(t, err)
+	require.Equal(t, 500, status)
+	require.Equal(t, 1, requests)
+
+	// a 503 with Retry-After is sent before the request is processed
+	httpC, closeServer := testHTTPClientFlaky(t, 1, http.StatusServiceUnavailable, &requests)
+	defer closeServer()
+	requests = 0
+	client, _ = NewClient(httpC, "testing", "", WithRetryPolicy(testRetryPolicy))
+	_, _, err = client.Upload(context.Background(), BytesSource([]byte("retried body")))
+	require.NoError(t, err)
+	require.Equal(t, 2, requests)
+
+	// idempotent requests are retried after any 5xx
+	httpC, closeServer = testHTTPClientFlaky(t, 1, http.StatusInternalServerError, &requests)
+	defer closeServer()
+	requests = 0
+	client, _ = NewClient(httpC, "testing", "", WithRetryPolicy(testRetryPolicy))
+	var image ImageInfo
+	_, _, err = client.Do(context.Background(), "DELETE", "image/ClF8rLe", nil, &image)
+	require.NoError(t, err)
+	require.Equal(t, 2, requests)
+}
+
+func TestRetryTransportErrors(t *testing.T) {
+	var requests int
+	httpC := &http.Client{Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		requests++
+		if requests == 1 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"data":{"id":"ClF8rLe"},"success":true,"status":200}`)),
+			Request:    r,
+		}, nil
+	})}
+	client, _ := NewClient(httpC, "testing", "", WithRetryPolicy(testRetryPolicy))
+
+	var image ImageInfo
+	_, _, err := client.Do(context.Background(), "DELETE", "image/ClF8rLe", nil, &image)
+	require.NoError(t, err)
+	require.Equal(t, 2, requests)
+
+	// the upload might have reached imgur before the connection broke
+	requests = 0
+	_, _, err = client.Upload(context.Background(), BytesSource([]byte("retried body")))
+	require.Error(t, err)
+	require.Equal(t, 1, requests)
+}
+
+func TestRetryDelay(t *testing.T) {
+	p := RetryPolicy{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	require.Equal(t, time.Second, p.delay(1, nil))
+	require.Equal(t, 2*time.Second, p.delay(2, nil))
+	require.Equal(t, 4*time.Second, p.delay(3, nil))
+	require.Equal(t, 5*time.Second, p.delay(4, nil))
+	require.Equal(t, 5*time.Second, p.delay(40, nil))
+
+	res := &http.Response{Header: http.Header{}}
+	res.Header.Set("Retry-After", "7")
+	require.Equal(t, 7*time.Second, p.delay(1, res))
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		d := p.delay(1, nil)
+		require.True(t, d >= 500*time.Millisecond && d <= 1500*time.Millisecond, d)
+	}
+}
@@ -1,6 +1,11 @@
 package imgur
 
-import "testing"
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
 
 func TestImgurNotSuccess(t *testing.T) {
 	httpC, server := testHTTPClientJSON("{\"data\": {}, \"success\": false, \"status\": 200 }")
@@ -101,7 +106,7 @@ func TestServerError(t *testing.T) {
 	httpC, server := testHTTPClient500()
 	defer server.Close()
 
-	client, _ := NewClient(httpC, "testing", "")
+	client, _ := NewClient(httpC, "testing", "", WithRetryPolicy(testRetryPolicy))
 
 	_, err := client.GetRateLimit()
 
@@ -200,7 +205,7 @@ func TestServerDown(t *testing.T) {
 	httpC, server := testHTTPClient500()
 	server.Close()
 
-	client, _ := NewClient(httpC, "testing", "")
+	client, _ := NewClient(httpC, "testing", "", WithRetryPolicy(testRetryPolicy))
 	_, err := client.GetRateLimit()
 
 	if err == nil {
@@ -244,3 +249,37 @@ func TestServerDown(t *testing.T) {
 		t.Error("UploadImage() should have failed, but didn't")
 	}
 }
+
+func TestCanceledContext(t *testing.T) {
+	httpC, server := testHTTPClientJSON("{\"data\": {}, \"success\": true, \"status\": 200 }")
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.GetRateLimitWithContext(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+
+	_, _, err = client.GetImageInfoWithContext(ctx, "asd")
+	require.ErrorIs(t, err, context.Canceled)
+
+	_, _, err = client.GetAlbumInfoWithContext(ctx, "asd")
+	require.ErrorIs(t, err, context.Canceled)
+
+	_, _, err = client.GetGalleryAlbumInfoWithContext(ctx, "asd")
+	require.ErrorIs(t, err, context.Canceled)
+
+	_, _, err = client.GetGalleryImageInfoWithContext(ctx, "asd")
+	require.ErrorIs(t, err, context.Canceled)
+
+	_, _, err = client.GetInfoFromURLWithContext(ctx, "https://imgur.com/asd")
+	require.ErrorIs(t, err, context.Canceled)
+
+	_, _, err = client.UploadImageWithContext(ctx, []byte("asd"), "", "file", "t", "d")
+	require.ErrorIs(t, err, context.Canceled)
+
+	_, err = client.RefreshAccessTokenWithContext(ctx, "refresh", "secret")
+	require.ErrorIs(t, err, context.Canceled)
+}
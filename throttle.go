@@ -0,0 +1,198 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ThrottleMode defines what the client does once the credits reported by imgur are used up
+type ThrottleMode int
+
+const (
+	// ThrottleOff sends all requests and leaves it to imgur to reject them
+	ThrottleOff ThrottleMode = iota
+	// ThrottleDelay waits until the user credits or the POST rate limit are reset,
+	// or until the time imgur asked for with the Retry-After header of a 429 response.
+	// Requests that need more client credits than remaining for the day are rejected.
+	ThrottleDelay
+	// ThrottleReject fails requests with ErrRateLimited
+	ThrottleReject
+)
+
+// uploadCredits is the number of credits imgur charges for an upload
+const uploadCredits = 10
+
+// WithThrottle sets how the client reacts to exhausted credits. Default is ThrottleOff.
+func WithThrottle(mode ThrottleMode) ClientOption {
+	return func(c *Client) {
+		c.throttleMode = mode
+	}
+}
+
+type throttleModeKey struct{}
+
+// contextWithThrottleMode overrides the throttle mode of the client for all requests bound to ctx
+func contextWithThrottleMode(ctx context.Context, mode ThrottleMode) context.Context {
+	return context.WithValue(ctx, throttleModeKey{}, mode)
+}
+
+// throttleModeFor returns the throttle mode that applies to a request bound to ctx
+func (client *Client) throttleModeFor(ctx context.Context) ThrottleMode {
+	if mode, ok := ctx.Value(throttleModeKey{}).(ThrottleMode); ok {
+		return mode
+	}
+	return client.throttleMode
+}
+
+// LastRateLimit returns the rate limits reported by imgur with the last response,
+// nil if no response carried rate limit headers yet. It is safe for concurrent use.
+func (client *Client) LastRateLimit() *RateLimit {
+	client.mu.Lock()
+	defer client.mu.Unlock()
+
+	if client.rateLimit == nil {
+		return nil
+	}
+	rl := *client.rateLimit
+	return &rl
+}
+
+// updateRateLimits remembers the rate limits sent with a response. Responses that only
+// carry some of the user and client, POST and RapidAPI limits keep the other known limits.
+// A 429 response with a Retry-After header holds back further requests, see throttle.
+func (client *Client) updateRateLimits(res *http.Response) {
+	if res.StatusCode == http.StatusTooManyRequests {
+		if d, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			client.mu.Lock()
+			if until := time.Now().Add(d); until.After(client.retryAfter) {
+				client.retryAfter = until
+			}
+			client.mu.Unlock()
+		}
+	}
+
+	h := res.Header
+	if !hasRateLimits(h) {
+		return
+	}
+	rl, err := extractRateLimits(h)
+	if err != nil {
+		client.Log.Infof("Problem with extracting rate limits: %v", err)
+		return
+	}
+
+	client.mu.Lock()
+	if last := client.rateLimit; last != nil {
+		merged := *last
+		if hasCreditRateLimits(h) {
+			merged.UserLimit, merged.UserRemaining, merged.UserReset = rl.UserLimit, rl.UserRemaining, rl.UserReset
+			merged.ClientLimit, merged.ClientRemaining = rl.ClientLimit, rl.ClientRemaining
+		}
+		if hasPostRateLimits(h) {
+			merged.PostLimit, merged.PostRemaining, merged.PostReset = rl.PostLimit, rl.PostRemaining, rl.PostReset
+		}
+		if hasRapidAPIRateLimits(h) {
+			merged.RapidAPILimit, merged.RapidAPIRemaining, merged.RapidAPIReset = rl.RapidAPILimit, rl.RapidAPIRemaining, rl.RapidAPIReset
+		}
+		*rl = merged
+	}
+	client.rateLimit = rl
+	low := client.lowCreditsFn != nil && rl.low(client.lowCreditsThreshold)
+	notify := low && !client.lowCredits
+	client.lowCredits = low
+	client.mu.Unlock()
+
+	if notify {
+		client.lowCreditsFn(*rl)
+	}
+}
+
+// WithLowCreditsCallback calls fn once the user or client credits, or the requests of the
+// RapidAPI plan, drop below threshold.
+// fn is called again only after the credits recovered in between, e.g. after a reset.
+func WithLowCreditsCallback(threshold int64, fn func(RateLimit)) ClientOption {
+	return func(c *Client) {
+		c.lowCreditsThreshold = threshold
+		c.lowCreditsFn = fn
+	}
+}
+
+// low reports whether the user or client credits or the RapidAPI requests are below threshold
+func (rl *RateLimit) low(threshold int64) bool {
+	return (rl.UserLimit > 0 && rl.UserRemaining < threshold) || (rl.ClientLimit > 0 && rl.ClientRemaining < threshold) ||
+		(rl.RapidAPILimit > 0 && rl.RapidAPIRemaining < threshold)
+}
+
+// requestCredits estimates the number of credits imgur charges for req
+func requestCredits(req *http.Request) int64 {
+	if isUpload(req) {
+		return uploadCredits
+	}
+	return 1
+}
+
+// isUpload reports whether req uploads an image or video
+func isUpload(req *http.Request) bool {
+	return req.Method == http.MethodPost && (strings.HasSuffix(req.URL.Path, "/image") || strings.HasSuffix(req.URL.Path, "/upload"))
+}
+
+// throttle checks the last known rate limits before req is sent.
+// It returns how long to wait before sending req or an error if req must not be sent.
+func (client *Client) throttle(req *http.Request) (time.Duration, error) {
+	mode := client.throttleModeFor(req.Context())
+	if mode == ThrottleOff {
+		return 0, nil
+	}
+	client.mu.Lock()
+	retryAfter := client.retryAfter
+	client.mu.Unlock()
+	if wait := time.Until(retryAfter); wait > 0 {
+		if mode == ThrottleReject {
+			return 0, fmt.Errorf("%w: imgur asked to retry after %v", ErrRateLimited, retryAfter)
+		}
+		return wait, nil
+	}
+	rl := client.LastRateLimit()
+	if rl == nil {
+		return 0, nil
+	}
+
+	credits := requestCredits(req)
+	if rl.ClientLimit > 0 && rl.ClientRemaining < credits {
+		return 0, fmt.Errorf("%w: %v of %v client credits remaining", ErrRateLimited, rl.ClientRemaining, rl.ClientLimit)
+	}
+	if rl.UserLimit > 0 && rl.UserRemaining < credits {
+		wait := time.Until(rl.UserReset)
+		if wait <= 0 {
+			return 0, nil
+		}
+		if mode == ThrottleReject {
+			return 0, fmt.Errorf("%w: %v of %v user credits remaining until %v", ErrRateLimited, rl.UserRemaining, rl.UserLimit, rl.UserReset)
+		}
+		return wait, nil
+	}
+	if rl.RapidAPILimit > 0 && rl.RapidAPIRemaining < 1 {
+		wait := time.Until(rl.RapidAPIReset)
+		if wait <= 0 {
+			return 0, nil
+		}
+		if mode == ThrottleReject {
+			return 0, fmt.Errorf("%w: none of %v RapidAPI requests remaining until %v", ErrRateLimited, rl.RapidAPILimit, rl.RapidAPIReset)
+		}
+		return wait, nil
+	}
+	if req.Method == http.MethodPost && rl.PostLimit > 0 && rl.PostRemaining < 1 {
+		wait := time.Until(rl.PostReset)
+		if wait <= 0 {
+			return 0, nil
+		}
+		if mode == ThrottleReject {
+			return 0, fmt.Errorf("%w: none of %v POST requests remaining until %v", ErrRateLimited, rl.PostLimit, rl.PostReset)
+		}
+		return wait, nil
+	}
+	return 0, nil
+}
@@ -0,0 +1,228 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testHTTPClientCredits(userRemaining, clientRemaining int, reset time.Time, requests *int) (*http.Client, func()) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		w.Header().Set("X-RateLimit-UserLimit", "500")
+		w.Header().Set("X-RateLimit-UserRemaining", strconv.Itoa(userRemaining))
+		w.Header().Set("X-RateLimit-UserReset", strconv.FormatInt(reset.Unix(), 10))
+		w.Header().Set("X-RateLimit-ClientLimit", "12500")
+		w.Header().Set("X-RateLimit-ClientRemaining", strconv.Itoa(clientRemaining))
+		fmt.Fprint(w, `{"data":{"id":"ClF8rLe"},"success":true,"status":200}`)
+	})
+	return httpC, server.Close
+}
+
+func TestLastRateLimit(t *testing.T) {
+	var requests int
+	reset := time.Now().Add(time.Hour)
+	httpC, closeServer := testHTTPClientCredits(400, 12000, reset, &requests)
+	defer closeServer()
+
+	client, _ := NewClient(httpC, "testing", "")
+	require.Nil(t, client.LastRateLimit())
+
+	_, _, err := client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+
+	rl := client.LastRateLimit()
+	require.NotNil(t, rl)
+	require.Equal(t, int64(500), rl.UserLimit)
+	require.Equal(t, int64(400), rl.UserRemaining)
+	require.Equal(t, int64(12500), rl.ClientLimit)
+	require.Equal(t, int64(12000), rl.ClientRemaining)
+	require.Equal(t, reset.Unix(), rl.UserReset.Unix())
+}
+
+func TestThrottleReject(t *testing.T) {
+	var requests int
+	httpC, closeServer := testHTTPClientCredits(0, 12000, time.Now().Add(time.Hour), &requests)
+	defer closeServer()
+
+	client, _ := NewClient(httpC, "testing", "", WithThrottle(ThrottleReject))
+	_, _, err := client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+
+	_, _, err = client.GetImageInfo("ClF8rLe")
+	require.ErrorIs(t, err, ErrRateLimited)
+	require.Equal(t, 1, requests)
+}
+
+func TestThrottleUploadCredits(t *testing.T) {
+	var requests int
+	httpC, closeServer := testHTTPClientCredits(100, 5, time.Now().Add(time.Hour), &requests)
+	defer closeServer()
+
+	client, _ := NewClient(httpC, "testing", "", WithThrottle(ThrottleDelay))
+	_, _, err := client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+
+	// 5 client credits are enough for another GET but not for an upload
+	_, _, err = client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+	_, _, err = client.Upload(context.Background(), BytesSource([]byte("image")))
+	require.ErrorIs(t, err, ErrRateLimited)
+	require.Equal(t, 2, requests)
+}
+
+func TestThrottleDelay(t *testing.T) {
+	var requests int
+	httpC, closeServer := testHTTPClientCredits(0, 12000, time.Now().Add(time.Hour), &requests)
+	defer closeServer()
+
+	client, _ := NewClient(httpC, "testing", "", WithThrottle(ThrottleDelay))
+	_, _, err := client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, _, err = client.GetImageInfoWithContext(ctx, "ClF8rLe")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, 1, requests)
+}
+
+func TestThrottleOff(t *testing.T) {
+	var requests int
+	httpC, closeServer := testHTTPClientCredits(0, 0, time.Now().Add(time.Hour), &requests)
+	defer closeServer()
+
+	client, _ := NewClient(httpC, "testing", "")
+	for i := 0; i < 3; i++ {
+		_, _, err := client.GetImageInfo("ClF8rLe")
+		require.NoError(t, err)
+	}
+	require.Equal(t, 3, requests)
+}
+
+func TestLowCreditsCallback(t *testing.T) {
+	remaining := []int{200, 80, 50, 500, 90}
+	i := 0
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-UserLimit", "500")
+		w.Header().Set("X-RateLimit-UserRemaining", strconv.Itoa(remaining[i]))
+		i++
+		fmt.Fprint(w, `{"data":{"id":"ClF8rLe"},"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	var alerts []int64
+	client, _ := NewClient(httpC, "testing", "", WithLowCreditsCallback(100, func(rl RateLimit) {
+		alerts = append(alerts, rl.UserRemaining)
+	}))
+	for range remaining {
+		_, _, err := client.GetImageInfo("ClF8rLe")
+		require.NoError(t, err)
+	}
+	// alerted when dropping below the threshold, again only after the reset
+	require.Equal(t, []int64{80, 90}, alerts)
+}
+
+func TestPostRateLimit(t *testing.T) {
+	var requests int
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Method == http.MethodPost {
+			w.Header().Set("X-Post-Rate-Limit-Limit", "1250")
+			w.Header().Set("X-Post-Rate-Limit-Remaining", "0")
+			w.Header().Set("X-Post-Rate-Limit-Reset", "600")
+		} else {
+			w.Header().Set("X-RateLimit-UserLimit", "500")
+			w.Header().Set("X-RateLimit-UserRemaining", "400")
+		}
+		fmt.Fprint(w, `{"data":{"id":"ClF8rLe"},"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithThrottle(ThrottleReject))
+	_, _, err := client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+	_, _, err = client.Upload(context.Background(), BytesSource([]byte("image")))
+	require.NoError(t, err)
+
+	// the POST limit was added to the known user limit
+	rl := client.LastRateLimit()
+	require.Equal(t, int64(400), rl.UserRemaining)
+	require.Equal(t, int64(1250), rl.PostLimit)
+	require.Equal(t, int64(0), rl.PostRemaining)
+	require.WithinDuration(t, time.Now().Add(10*time.Minute), rl.PostReset, 5*time.Second)
+
+	_, _, err = client.Upload(context.Background(), BytesSource([]byte("image")))
+	require.ErrorIs(t, err, ErrRateLimited)
+	_, _, err = client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+	require.Equal(t, 3, requests)
+	require.Equal(t, int64(1250), client.LastRateLimit().PostLimit)
+}
+
+func TestThrottleRetryAfter(t *testing.T) {
+	var requests int
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithThrottle(ThrottleReject), WithRetryPolicy(NoRetry))
+	_, _, err := client.GetImageInfo("ClF8rLe")
+	require.Error(t, err)
+
+	_, _, err = client.GetImageInfo("ClF8rLe")
+	require.ErrorIs(t, err, ErrRateLimited)
+	require.Equal(t, 1, requests)
+
+	client.throttleMode = ThrottleDelay
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, _, err = client.GetImageInfoWithContext(ctx, "ClF8rLe")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, 1, requests)
+}
+
+func TestRapidAPIRateLimit(t *testing.T) {
+	remaining := 1
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "imgur-apiv3.p.rapidapi.com", r.Header.Get("x-rapidapi-host"))
+		require.Equal(t, "rapid", r.Header.Get("x-rapidapi-key"))
+		w.Header().Set("X-RateLimit-Requests-Limit", "100")
+		w.Header().Set("X-RateLimit-Requests-Remaining", strconv.Itoa(remaining))
+		w.Header().Set("X-RateLimit-Requests-Reset", "3600")
+		remaining--
+		fmt.Fprint(w, `{"data":{"id":"ClF8rLe"},"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	var hosts []string
+	client, _ := NewClient(httpC, "testing", "rapid", WithThrottle(ThrottleReject),
+		WithTransportMiddleware(func(next http.RoundTripper) http.RoundTripper {
+			return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				hosts = append(hosts, r.URL.Host)
+				return next.RoundTrip(r)
+			})
+		}))
+	_, _, err := client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+	_, _, err = client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+	require.Equal(t, []string{"imgur-apiv3.p.rapidapi.com", "imgur-apiv3.p.rapidapi.com"}, hosts)
+
+	rl := client.LastRateLimit()
+	require.Equal(t, int64(100), rl.RapidAPILimit)
+	require.Equal(t, int64(0), rl.RapidAPIRemaining)
+	require.WithinDuration(t, time.Now().Add(time.Hour), rl.RapidAPIReset, 5*time.Second)
+
+	_, _, err = client.GetImageInfo("ClF8rLe")
+	require.ErrorIs(t, err, ErrRateLimited)
+	require.Len(t, hosts, 2)
+}
@@ -0,0 +1,69 @@
+package imgur
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// WithRequestTimeout limits how long a single attempt of a request may take, including
+// reading the response. Unlike the Timeout of the http.Client, it applies per request, so
+// uploads can be given more time with WithUploadTimeout. A timed out GET request is
+// retried according to the retry policy. 0 disables the timeout, which is the default.
+// Downloads of images are only bound to the context they are requested with.
+func WithRequestTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.requestTimeout = timeout
+	}
+}
+
+// WithUploadTimeout limits how long a single attempt of an upload may take, replacing
+// the timeout of WithRequestTimeout for uploads. 0 applies the request timeout to uploads too.
+func WithUploadTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.uploadTimeout = timeout
+	}
+}
+
+type requestTimeoutKey struct{}
+
+// ContextWithRequestTimeout overrides the request and upload timeouts of the client for
+// all requests bound to ctx, 0 disables them. Unlike context.WithTimeout, the timeout
+// applies to every attempt of a request and not to all of them together.
+func ContextWithRequestTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, requestTimeoutKey{}, timeout)
+}
+
+// requestTimeoutFor returns the timeout that applies to an attempt of req
+func (client *Client) requestTimeoutFor(req *http.Request) time.Duration {
+	if timeout, ok := req.Context().Value(requestTimeoutKey{}).(time.Duration); ok {
+		return timeout
+	}
+	if client.uploadTimeout > 0 && isUpload(req) {
+		return client.uploadTimeout
+	}
+	return client.requestTimeout
+}
+
+// withTimeout binds req to a context that is canceled after timeout, the returned
+// function cancels it early. Without a timeout req is returned as it is.
+func withTimeout(req *http.Request, timeout time.Duration) (*http.Request, context.CancelFunc) {
+	if timeout <= 0 {
+		return req, func() {}
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	return req.WithContext(ctx), cancel
+}
+
+// cancelBody cancels the context of a request once its response was read
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
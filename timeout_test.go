@@ -0,0 +1,67 @@
+package imgur
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testHTTPClientSlow(delay time.Duration, requests *int) (*http.Client, func()) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		if *requests == 1 || r.Method == http.MethodPost {
+			time.Sleep(delay)
+		}
+		fmt.Fprint(w, `{"data":{"id":"ClF8rLe"},"success":true,"status":200}`)
+	})
+	return httpC, server.Close
+}
+
+func TestRequestTimeout(t *testing.T) {
+	var requests int
+	httpC, closeServer := testHTTPClientSlow(200*time.Millisecond, &requests)
+	defer closeServer()
+
+	policy := RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond}
+	client, _ := NewClient(httpC, "testing", "", WithRequestTimeout(50*time.Millisecond), WithRetryPolicy(policy))
+	img, _, err := client.GetImageInfo("ClF8rLe")
+	require.NoError(t, err)
+	require.Equal(t, ImageID("ClF8rLe"), img.ID)
+	// the first attempt timed out and was retried
+	require.Equal(t, 2, requests)
+
+	requests = 0
+	client, _ = NewClient(httpC, "testing", "", WithRequestTimeout(50*time.Millisecond), WithRetryPolicy(NoRetry))
+	_, _, err = client.GetImageInfo("ClF8rLe")
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestUploadTimeout(t *testing.T) {
+	var requests int
+	httpC, closeServer := testHTTPClientSlow(100*time.Millisecond, &requests)
+	defer closeServer()
+
+	client, _ := NewClient(httpC, "testing", "", WithRequestTimeout(time.Millisecond), WithUploadTimeout(time.Second))
+	_, _, err := client.Upload(context.Background(), BytesSource([]byte("image")))
+	require.NoError(t, err)
+
+	client, _ = NewClient(httpC, "testing", "", WithRequestTimeout(time.Millisecond))
+	_, _, err = client.Upload(context.Background(), BytesSource([]byte("image")))
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestContextWithRequestTimeout(t *testing.T) {
+	var requests int
+	httpC, closeServer := testHTTPClientSlow(100*time.Millisecond, &requests)
+	defer closeServer()
+
+	client, _ := NewClient(httpC, "testing", "", WithRequestTimeout(time.Millisecond))
+	_, _, err := client.GetImageInfoWithContext(ContextWithRequestTimeout(context.Background(), 0), "ClF8rLe")
+	require.NoError(t, err)
+	require.Equal(t, 1, requests)
+}
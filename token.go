@@ -0,0 +1,40 @@
+package imgur
+
+import (
+	"context"
+	"time"
+)
+
+// Token is the OAuth token of an imgur user
+type Token struct {
+	AccessToken     string    `json:"access_token"`               // Token used to authenticate requests
+	RefreshToken    string    `json:"refresh_token"`              // Token used to get a new access token
+	TokenType       string    `json:"token_type,omitempty"`       // Usually bearer
+	Expiry          time.Time `json:"expiry,omitempty"`           // When the access token expires, zero if unknown
+	AccountID       int       `json:"account_id,omitempty"`       // ID of the account the token belongs to
+	AccountUsername string    `json:"account_username,omitempty"` // Username of the account the token belongs to
+}
+
+// Token converts the response of the token endpoint into a Token
+func (r *GenerateAccessTokenResponse) Token() *Token {
+	t := &Token{
+		AccessToken:     r.AccessToken,
+		RefreshToken:    r.RefreshToken,
+		TokenType:       r.TokenType,
+		AccountID:       r.AccountID,
+		AccountUsername: r.AccountUserName,
+	}
+	if r.ExpiresIn > 0 {
+		t.Expiry = time.Now().Add(time.Duration(r.ExpiresIn) * time.Second)
+	}
+	return t
+}
+
+// TokenSource supplies the OAuth token of a client and renews it once it expired.
+// Implementations have to be safe for concurrent use.
+type TokenSource interface {
+	// Token returns the token the next request is authenticated with.
+	Token(ctx context.Context) (*Token, error)
+	// Refresh returns a new token after imgur rejected expired.
+	Refresh(ctx context.Context, expired *Token) (*Token, error)
+}
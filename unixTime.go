@@ -0,0 +1,34 @@
+package imgur
+
+import (
+	"bytes"
+	"strconv"
+	"time"
+)
+
+// UnixTime is a point in time as sent by imgur, in seconds since the epoch.
+// It decodes numbers, numbers in strings and null, which is 0.
+type UnixTime int64
+
+// Time returns t as time.Time, the zero time.Time if t is 0
+func (t UnixTime) Time() time.Time {
+	if t == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(t), 0)
+}
+
+// UnmarshalJSON decodes t from a JSON number or string
+func (t *UnixTime) UnmarshalJSON(data []byte) error {
+	data = bytes.Trim(data, `"`)
+	if len(data) == 0 || string(data) == "null" || string(data) == "false" {
+		*t = 0
+		return nil
+	}
+	f, err := strconv.ParseFloat(string(data), 64)
+	if err != nil {
+		return err
+	}
+	*t = UnixTime(f)
+	return nil
+}
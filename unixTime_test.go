@@ -0,0 +1,41 @@
+package imgur
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnixTime(t *testing.T) {
+	var v struct {
+		A UnixTime `json:"a"`
+		B UnixTime `json:"b"`
+		C UnixTime `json:"c"`
+		D UnixTime `json:"d"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(`{"a":1451248840,"b":"1451248840","c":null,"d":false}`), &v))
+	require.Equal(t, UnixTime(1451248840), v.A)
+	require.Equal(t, v.A, v.B)
+	require.Equal(t, time.Unix(1451248840, 0), v.A.Time())
+	require.True(t, v.C.Time().IsZero())
+	require.True(t, v.D.Time().IsZero())
+
+	require.Error(t, json.Unmarshal([]byte(`{"a":"yesterday"}`), &v))
+
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"a":1451248840,"b":1451248840,"c":0,"d":0}`, string(data))
+}
+
+func TestCreatedAtAccessors(t *testing.T) {
+	created := time.Unix(1460715031, 0)
+	require.Equal(t, created, (&AlbumInfo{DateTime: 1460715031}).CreatedAt())
+	require.Equal(t, created, (&GalleryAlbumInfo{DateTime: 1460715031}).CreatedAt())
+	require.Equal(t, created, (&GalleryImageInfo{Datetime: 1460715031}).CreatedAt())
+	require.Equal(t, created, (&Comment{Datetime: 1460715031}).CreatedAt())
+	require.Equal(t, created, (&Message{Datetime: 1460715031}).SentAt())
+	require.Equal(t, created, (&Conversation{Datetime: 1460715031}).LastMessageAt())
+	require.True(t, (&ImageInfo{}).CreatedAt().IsZero())
+}
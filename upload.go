@@ -0,0 +1,155 @@
+package imgur
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// UploadSource describes where the data of an upload comes from.
+// Use one of the *Source functions to create it.
+type UploadSource struct {
+	dtype UploadType
+	video bool   // upload as video instead of image
+	value string // the URL or base64 data for uploads without a file part
+	name  string // default name of the upload
+
+	// replayable is set if open can be called more than once
+	replayable bool
+
+	// open returns the file data and its size, -1 if the size is unknown
+	open func() (io.ReadCloser, int64, error)
+}
+
+// ReaderSource uploads the content of r. size is the number of bytes that
+// will be read from r, pass -1 if it is unknown.
+func ReaderSource(r io.Reader, size int64) UploadSource {
+	return UploadSource{
+		dtype: FileType,
+		open: func() (io.ReadCloser, int64, error) {
+			if r == nil {
+				return nil, -1, errors.New("Invalid image reader")
+			}
+			return ioutil.NopCloser(r), size, nil
+		},
+	}
+}
+
+// BytesSource uploads the binary image data in b.
+func BytesSource(b []byte) UploadSource {
+	return UploadSource{
+		dtype:      FileType,
+		replayable: true,
+		open: func() (io.ReadCloser, int64, error) {
+			if b == nil {
+				return nil, -1, errors.New("Invalid image")
+			}
+			return ioutil.NopCloser(bytes.NewReader(b)), int64(len(b)), nil
+		},
+	}
+}
+
+// FileSource uploads the file given by filename. The base name of the file
+// is used as name of the upload unless WithName is passed.
+func FileSource(filename string) UploadSource {
+	return UploadSource{
+		dtype:      FileType,
+		name:       filepath.Base(filename),
+		replayable: true,
+		open:       func() (io.ReadCloser, int64, error) { return openUploadFile(filename) },
+	}
+}
+
+// FSSource uploads the file name of fsys, like FileSource.
+func FSSource(fsys fs.FS, name string) UploadSource {
+	return UploadSource{
+		dtype:      FileType,
+		name:       path.Base(name),
+		replayable: true,
+		open: func() (io.ReadCloser, int64, error) {
+			f, err := fsys.Open(name)
+			if err != nil {
+				return nil, -1, fmt.Errorf("Could not open file %v - Error: %w", name, err)
+			}
+			info, err := f.Stat()
+			if err != nil {
+				f.Close()
+				return nil, -1, fmt.Errorf("Could not stat file %v - Error: %w", name, err)
+			}
+			return f, info.Size(), nil
+		},
+	}
+}
+
+// Base64Source uploads base64 encoded image data.
+func Base64Source(data string) UploadSource {
+	return UploadSource{dtype: Base64Type, value: data}
+}
+
+// URLSource lets imgur fetch the image found at imageURL.
+func URLSource(imageURL string) UploadSource {
+	return UploadSource{dtype: URLType, value: imageURL}
+}
+
+// VideoReaderSource uploads the video read from r, see ReaderSource.
+func VideoReaderSource(r io.Reader, size int64) UploadSource {
+	s := ReaderSource(r, size)
+	s.video = true
+	return s
+}
+
+// VideoFileSource uploads the video file given by filename, see FileSource.
+func VideoFileSource(filename string) UploadSource {
+	s := FileSource(filename)
+	s.video = true
+	return s
+}
+
+func openUploadFile(filename string) (io.ReadCloser, int64, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, -1, fmt.Errorf("Could not open file %v - Error: %w", filename, err)
+	}
+	fileinfo, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, -1, fmt.Errorf("Could not stat file %v - Error: %w", filename, err)
+	}
+	return f, fileinfo.Size(), nil
+}
+
+// Upload uploads source to imgur. All optional parameters are passed as options,
+// e.g. client.Upload(ctx, FileSource("cat.png"), WithTitle("cat"), WithAlbum(albumID))
+// returns image info, status code of the upload, error
+func (client *Client) Upload(ctx context.Context, source UploadSource, opts ...UploadOption) (*ImageInfo, int, error) {
+	o := newUploadOptions(source, opts)
+	o.validate = !client.skipUploadValidation
+
+	switch source.dtype {
+	case URLType:
+		if err := validateImageURL(source.value); err != nil {
+			return nil, -1, err
+		}
+		return client.uploadValue(ctx, source.dtype, source.value, o)
+	case Base64Type:
+		if source.value == "" {
+			return nil, -1, errors.New("Invalid image")
+		}
+		return client.uploadValue(ctx, source.dtype, source.value, o)
+	case FileType:
+	default:
+		return nil, -1, errors.New("Invalid upload source")
+	}
+
+	if source.video {
+		return client.uploadStream(ctx, "upload", "video", source, o)
+	}
+	return client.uploadStream(ctx, "image", "image", source, o)
+}
@@ -0,0 +1,67 @@
+package imgur
+
+import "context"
+
+// UploadBuilder collects the parameters of an upload, create it with Client.NewUpload:
+//
+//	res, err := client.NewUpload(FileSource("cat.png")).Title("cat").Album(albumID).Do(ctx)
+//
+// It is not safe for concurrent use.
+type UploadBuilder struct {
+	client *Client
+	source UploadSource
+	opts   []UploadOption
+}
+
+// NewUpload starts an upload of source, it is sent by Do of the returned builder
+func (client *Client) NewUpload(source UploadSource) *UploadBuilder {
+	return &UploadBuilder{client: client, source: source}
+}
+
+// Title sets the title of the uploaded image
+func (b *UploadBuilder) Title(title string) *UploadBuilder {
+	return b.With(WithTitle(title))
+}
+
+// Description sets the description of the uploaded image
+func (b *UploadBuilder) Description(description string) *UploadBuilder {
+	return b.With(WithDescription(description))
+}
+
+// Album adds the uploaded image to album, see WithAlbum
+func (b *UploadBuilder) Album(album AlbumRef) *UploadBuilder {
+	return b.With(WithAlbum(album))
+}
+
+// Name sets the file name imgur stores for the upload
+func (b *UploadBuilder) Name(name string) *UploadBuilder {
+	return b.With(WithName(name))
+}
+
+// ContentType sets the MIME type of the uploaded file instead of sniffing it
+func (b *UploadBuilder) ContentType(mimeType string) *UploadBuilder {
+	return b.With(WithContentType(mimeType))
+}
+
+// Progress informs progress about the upload progress
+func (b *UploadBuilder) Progress(progress ProgressFunc) *UploadBuilder {
+	return b.With(WithProgress(progress))
+}
+
+// DisableAudio removes the audio track from an uploaded video
+func (b *UploadBuilder) DisableAudio() *UploadBuilder {
+	return b.With(WithDisableAudio())
+}
+
+// With applies opts to the upload, e.g. options that have no method of their own
+func (b *UploadBuilder) With(opts ...UploadOption) *UploadBuilder {
+	b.opts = append(b.opts, opts...)
+	return b
+}
+
+// Do sends the upload. The result is returned even if the upload failed,
+// with the status code of the upload and the returned error in Err.
+func (b *UploadBuilder) Do(ctx context.Context) (*UploadResult, error) {
+	img, status, err := b.client.Upload(ctx, b.source, b.opts...)
+	return &UploadResult{Source: b.source, Image: img, Status: status, Err: err}, err
+}
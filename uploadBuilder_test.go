@@ -0,0 +1,49 @@
+package imgur
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadBuilder(t *testing.T) {
+	httpC, closeServer := testUploadServer(t, func(r *http.Request) {
+		require.Equal(t, "/3/image", r.URL.Path)
+		require.Equal(t, title, r.FormValue("title"))
+		require.Equal(t, descr, r.FormValue("description"))
+		require.Equal(t, "ALBUMID", r.FormValue("album"))
+		require.Equal(t, "cat.png", r.FormValue("name"))
+		require.Equal(t, "bytes", formFile(t, r, "image"))
+	})
+	defer closeServer()
+
+	client, _ := NewClient(httpC, "testing", "")
+	var sent int64
+	source := BytesSource([]byte("bytes"))
+	res, err := client.NewUpload(source).
+		Title(title).
+		Description(descr).
+		Album(AlbumID("ALBUMID")).
+		Name("cat.png").
+		Progress(func(s, total int64) { sent = s }).
+		Do(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, ImageID("ClF8rLe"), res.Image.ID)
+	require.Equal(t, 200, res.Status)
+	require.NoError(t, res.Err)
+	require.Greater(t, sent, int64(0))
+}
+
+func TestUploadBuilderError(t *testing.T) {
+	httpC, closeServer := testHTTPClientError(429, `{"data":{"error":"Too fast"},"success":false,"status":429}`)
+	defer closeServer()
+
+	client, _ := NewClient(httpC, "testing", "", WithRetryPolicy(testRetryPolicy))
+	res, err := client.NewUpload(BytesSource([]byte("bytes"))).Do(context.Background())
+	require.ErrorIs(t, err, ErrRateLimited)
+	require.Equal(t, 429, res.Status)
+	require.Nil(t, res.Image)
+	require.Equal(t, err, res.Err)
+}
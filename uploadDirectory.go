@@ -0,0 +1,94 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strings"
+)
+
+// UploadExtensions returns the lower case file extensions of the images and videos imgur
+// accepts, which are the files UploadFSAsAlbum and SyncDirectory upload.
+func UploadExtensions() []string {
+	extensions := make([]string, 0, len(uploadExtensions))
+	for ext := range uploadExtensions {
+		extensions = append(extensions, ext)
+	}
+	sort.Strings(extensions)
+	return extensions
+}
+
+// UploadDirectoryAsAlbum uploads all images and videos found in dir and its subdirectories
+// and creates an album of them, see UploadFSAsAlbum.
+func (client *Client) UploadDirectoryAsAlbum(ctx context.Context, dir string, opts AlbumOptions) (*CreatedAlbum, []UploadResult, error) {
+	return client.UploadFSAsAlbum(ctx, os.DirFS(dir), opts)
+}
+
+// UploadFSAsAlbum uploads all images and videos of fsys and creates an album of them.
+// The album keeps the lexical order of the file paths, files of other types are ignored.
+// Images that failed to upload are left out of the album and reported in the results.
+// Anonymous clients create an anonymous album from the deletehashes of the images.
+// returns the album, one result per uploaded file, error
+func (client *Client) UploadFSAsAlbum(ctx context.Context, fsys fs.FS, opts AlbumOptions) (*CreatedAlbum, []UploadResult, error) {
+	names, err := findUploadFiles(fsys)
+	if err != nil {
+		return nil, nil, err
+	}
+	sources := make([]UploadSource, len(names))
+	for i, name := range names {
+		sources[i] = fsUploadSource(fsys, name)
+	}
+	if len(sources) == 0 {
+		return nil, nil, fmt.Errorf("No images found to upload")
+	}
+
+	results := client.UploadMany(ctx, sources, BulkOptions{})
+	opts.ImageIDs, opts.DeleteHashes = nil, nil
+	for _, result := range results {
+		switch {
+		case result.Err != nil:
+			client.Log.Warningf("Upload of %v failed: %v", result.Source.name, result.Err)
+		case client.authenticated():
+			opts.ImageIDs = append(opts.ImageIDs, result.Image.ID)
+		default:
+			opts.DeleteHashes = append(opts.DeleteHashes, result.Image.Deletehash)
+		}
+	}
+	if len(opts.ImageIDs) == 0 && len(opts.DeleteHashes) == 0 {
+		return nil, results, fmt.Errorf("None of the %v uploads succeeded - %w", len(results), results[0].Err)
+	}
+
+	album, _, err := client.CreateAlbum(ctx, opts)
+	if err != nil {
+		return nil, results, err
+	}
+	return album, results, nil
+}
+
+// findUploadFiles returns the paths of all files in fsys imgur accepts, in lexical order
+func findUploadFiles(fsys fs.FS) ([]string, error) {
+	var names []string
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if _, ok := uploadExtensions[strings.ToLower(path.Ext(name))]; ok && !d.IsDir() {
+			names = append(names, name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Problem reading files to upload - %w", err)
+	}
+	return names, nil
+}
+
+// fsUploadSource returns the source of the file name of fsys, a video source for video files
+func fsUploadSource(fsys fs.FS, name string) UploadSource {
+	source := FSSource(fsys, name)
+	source.video = uploadExtensions[strings.ToLower(path.Ext(name))]
+	return source
+}
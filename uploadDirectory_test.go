@@ -0,0 +1,104 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testAlbumUploadServer(t *testing.T, albumForm *map[string][]string) (*http.Client, func()) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/3/image", "/3/upload":
+			require.NoError(t, r.ParseMultipartForm(1<<20))
+			field := "image"
+			if r.URL.Path == "/3/upload" {
+				field = "video"
+			}
+			data := formFile(t, r, field)
+			if data == "broken" {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"data":{"error":"Bad image"},"success":false,"status":400}`)
+				return
+			}
+			fmt.Fprintf(w, `{"data":{"id":"%v","deletehash":"hash-%v"},"success":true,"status":200}`, data, data)
+		case "/3/album":
+			require.NoError(t, r.ParseForm())
+			*albumForm = r.PostForm
+			fmt.Fprint(w, `{"data":{"id":"VZQXk","deletehash":"albumhash"},"success":true,"status":200}`)
+		default:
+			t.Errorf("Unexpected request to %v", r.URL.Path)
+		}
+	})
+	return httpC, server.Close
+}
+
+func TestUploadFSAsAlbum(t *testing.T) {
+	var form map[string][]string
+	httpC, closeServer := testAlbumUploadServer(t, &form)
+	defer closeServer()
+
+	fsys := fstest.MapFS{
+		"b.png":        {Data: []byte("b")},
+		"a.jpg":        {Data: []byte("a")},
+		"notes.txt":    {Data: []byte("ignored")},
+		"sub/c.MP4":    {Data: []byte("c")},
+		"sub/bad.webp": {Data: []byte("broken")},
+	}
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	album, results, err := client.UploadFSAsAlbum(context.Background(), fsys, AlbumOptions{Title: "Cats"})
+	require.NoError(t, err)
+	require.Equal(t, AlbumID("VZQXk"), album.ID)
+	require.Len(t, results, 4)
+	require.Error(t, results[2].Err)
+	require.Equal(t, "Cats", form["title"][0])
+	require.Equal(t, []string{"a", "b", "c"}, form["ids[]"])
+}
+
+func TestUploadDirectoryAsAlbumAnonymous(t *testing.T) {
+	var form map[string][]string
+	httpC, closeServer := testAlbumUploadServer(t, &form)
+	defer closeServer()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "1.gif"), []byte("x"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "2.jpeg"), []byte("y"), 0644))
+
+	client, _ := NewClient(httpC, "testing", "")
+	album, _, err := client.UploadDirectoryAsAlbum(context.Background(), dir, AlbumOptions{})
+	require.NoError(t, err)
+	require.Equal(t, DeleteHash("albumhash"), album.Deletehash)
+	require.Equal(t, []string{"hash-x", "hash-y"}, form["deletehashes[]"])
+
+	_, _, err = client.UploadDirectoryAsAlbum(context.Background(), t.TempDir(), AlbumOptions{})
+	require.Error(t, err)
+	_, _, err = client.UploadFSAsAlbum(context.Background(), fstest.MapFS{"x.png": {Data: []byte("broken")}}, AlbumOptions{})
+	require.True(t, strings.Contains(err.Error(), "None of the 1 uploads"))
+}
+
+func TestFindUploadFilesAcceptedTypes(t *testing.T) {
+	// every format the uploads are validated against is uploaded from directories
+	fsys := fstest.MapFS{
+		"a.bmp":     {Data: []byte("a")},
+		"b.tif":     {Data: []byte("b")},
+		"c.wmv":     {Data: []byte("c")},
+		"d.FLV":     {Data: []byte("d")},
+		"notes.txt": {Data: []byte("ignored")},
+	}
+	names, err := findUploadFiles(fsys)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a.bmp", "b.tif", "c.wmv", "d.FLV"}, names)
+	require.Contains(t, UploadExtensions(), ".tif")
+	require.Len(t, UploadExtensions(), len(uploadExtensions))
+	for _, ft := range uploadFileTypes {
+		require.NotEmpty(t, uploadTypes[ft.mimeType], ft.ext)
+		require.Equal(t, ft.video, uploadExtensions[ft.ext], ft.ext)
+	}
+}
@@ -0,0 +1,82 @@
+package imgur
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"sync"
+)
+
+// EncodeFormat is the file format UploadGoImage encodes an image.Image with
+type EncodeFormat int
+
+// Formats of UploadGoImage
+const (
+	EncodePNG  EncodeFormat = iota // Lossless PNG, good for charts and screenshots
+	EncodeJPEG                     // JPEG with quality 90, good for photos
+)
+
+// jpegQuality is the quality of images encoded with EncodeJPEG
+const jpegQuality = 90
+
+// pngEncoder reuses the buffers of its compressor between the images it encodes
+var pngEncoder = &png.Encoder{BufferPool: &pngBuffers{}}
+
+// pngBuffers is the png.EncoderBufferPool of pngEncoder
+type pngBuffers struct {
+	pool sync.Pool
+}
+
+func (p *pngBuffers) Get() *png.EncoderBuffer {
+	b, _ := p.pool.Get().(*png.EncoderBuffer)
+	return b
+}
+
+func (p *pngBuffers) Put(b *png.EncoderBuffer) {
+	p.pool.Put(b)
+}
+
+// UploadGoImage encodes img in format and uploads it, so generated images do not have to be
+// written to a file first. The image is encoded while it is uploaded and encoded again if
+// the upload is retried, so the encoded file is never held in memory.
+// returns image info, status code of the upload, error
+func (client *Client) UploadGoImage(ctx context.Context, img image.Image, format EncodeFormat, opts ...UploadOption) (*ImageInfo, int, error) {
+	if img == nil {
+		return nil, -1, errors.New("Invalid image")
+	}
+
+	var encode func(io.Writer) error
+	switch format {
+	case EncodePNG:
+		encode = func(w io.Writer) error { return pngEncoder.Encode(w, img) }
+	case EncodeJPEG:
+		encode = func(w io.Writer) error { return jpeg.Encode(w, img, &jpeg.Options{Quality: jpegQuality}) }
+	default:
+		return nil, -1, fmt.Errorf("Invalid encode format %v", format)
+	}
+	return client.Upload(ctx, encodeSource(encode), opts...)
+}
+
+// encodeSource uploads the file written by encode, which is called by a goroutine each
+// time the source is opened
+func encodeSource(encode func(io.Writer) error) UploadSource {
+	return UploadSource{
+		dtype:      FileType,
+		replayable: true,
+		open: func() (io.ReadCloser, int64, error) {
+			pr, pw := io.Pipe()
+			go func() {
+				err := encode(pw)
+				if err != nil {
+					err = fmt.Errorf("Could not encode image - %w", err)
+				}
+				pw.CloseWithError(err)
+			}()
+			return pr, -1, nil
+		},
+	}
+}
@@ -0,0 +1,90 @@
+package imgur
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadGoImage(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 4, 3))
+	img.Set(1, 1, color.RGBA{R: 255, A: 255})
+
+	tests := []struct {
+		format      EncodeFormat
+		contentType string
+		decode      func(data []byte) (image.Image, error)
+	}{
+		{EncodePNG, "image/png", func(data []byte) (image.Image, error) { return png.Decode(bytes.NewReader(data)) }},
+		{EncodeJPEG, "image/jpeg", func(data []byte) (image.Image, error) { return jpeg.Decode(bytes.NewReader(data)) }},
+	}
+	for _, test := range tests {
+		httpC, closeServer := testUploadServer(t, func(r *http.Request) {
+			require.Equal(t, title, r.FormValue("title"))
+			require.Equal(t, test.contentType, r.MultipartForm.File["image"][0].Header.Get("Content-Type"))
+			decoded, err := test.decode([]byte(formFile(t, r, "image")))
+			require.NoError(t, err)
+			require.Equal(t, img.Bounds(), decoded.Bounds())
+		})
+		client, _ := NewClient(httpC, "testing", "")
+		_, _, err := client.UploadGoImage(context.Background(), img, test.format, WithTitle(title))
+		require.NoError(t, err)
+		closeServer()
+	}
+
+	client, _ := NewClient(new(http.Client), "testing", "")
+	_, _, err := client.UploadGoImage(context.Background(), nil, EncodePNG)
+	require.Error(t, err)
+	_, _, err = client.UploadGoImage(context.Background(), img, EncodeFormat(42))
+	require.Error(t, err)
+}
+
+func TestUploadGoImageRetried(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 4, 3))
+	var requests int
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		f, _, err := r.FormFile("image")
+		require.NoError(t, err)
+		decoded, err := png.Decode(f)
+		require.NoError(t, err)
+		require.Equal(t, img.Bounds(), decoded.Bounds())
+		if requests == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"id":"ClF8rLe"},"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithRetryPolicy(testRetryPolicy))
+	_, _, err := client.UploadGoImage(context.Background(), img, EncodePNG)
+	require.NoError(t, err)
+	require.Equal(t, 2, requests)
+}
+
+func BenchmarkUploadGoImage(b *testing.B) {
+	img := image.NewRGBA(image.Rect(0, 0, 512, 512))
+	for x := 0; x < 512; x++ {
+		for y := 0; y < 512; y++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: uint8(x ^ y), A: 255})
+		}
+	}
+	client, _ := New("testing", WithHTTPClient(discardUploads()))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := client.UploadGoImage(context.Background(), img, EncodePNG); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -1,15 +1,20 @@
 package imgur
 
 import (
+	"bufio"
 	"bytes"
+	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"mime/multipart"
-	"net/http"
-	"os"
-	"strconv"
+	"net/textproto"
+	"path"
+	"strings"
+	"sync"
 )
 
 // UploadImage uploads the image to imgur
@@ -18,101 +23,290 @@ import (
 //
 //	For anonymous albums, album should be the deletehash that is returned at creation.
 //
-// dtype                The type of the file that's being sent; file, base64 or URL
+// dtype                The type of the file that's being sent; FileType, Base64Type or URLType
 // title       optional The title of the image.
 // description optional The description of the image.
 // returns image info, status code of the upload, error
-func (client *Client) UploadImage(image []byte, album string, dtype string, title string, description string) (*ImageInfo, int, error) {
+func (client *Client) UploadImage(image []byte, album string, dtype UploadType, title string, description string) (*ImageInfo, int, error) {
+	return client.UploadImageWithContext(context.Background(), image, album, dtype, title, description)
+}
+
+// UploadImageWithContext is like UploadImage, but the upload is bound to ctx
+func (client *Client) UploadImageWithContext(ctx context.Context, image []byte, album string, dtype UploadType, title string, description string) (*ImageInfo, int, error) {
 	if image == nil {
 		return nil, -1, errors.New("Invalid image")
 	}
-	if dtype != "file" && dtype != "base64" && dtype != "URL" {
-		return nil, -1, errors.New("Passed invalid dtype: " + dtype + ". Please use file/base64/URL.")
+
+	dtype, err := ParseUploadType(string(dtype))
+	if err != nil {
+		return nil, -1, err
+	}
+	var source UploadSource
+	switch dtype {
+	case FileType:
+		source = BytesSource(image)
+	case Base64Type:
+		source = Base64Source(string(image))
+	case URLType:
+		source = URLSource(string(image))
 	}
 
-	reqbody := &bytes.Buffer{}
-	writer := multipart.NewWriter(reqbody)
-	createUploadForm(writer, image, album, dtype, title, description)
-	writer.Close()
+	return client.Upload(ctx, source, WithAlbum(AlbumID(album)), WithTitle(title), WithDescription(description))
+}
 
-	URL := client.createAPIURL("image")
-	req, err := http.NewRequest("POST", URL, reqbody)
-	client.Log.Debugf("Posting to URL %v\n", URL)
-	if err != nil {
-		return nil, -1, errors.New("Could create request for " + URL + " - " + err.Error())
+// UploadImageFromReader uploads the content of r to imgur. The data is streamed into
+// the request body and is never held in memory as a whole.
+// size is the number of bytes that will be read from r, pass -1 if it is unknown.
+// returns image info, status code of the upload, error
+func (client *Client) UploadImageFromReader(ctx context.Context, r io.Reader, size int64, opts ...UploadOption) (*ImageInfo, int, error) {
+	return client.Upload(ctx, ReaderSource(r, size), opts...)
+}
+
+// UploadFromFS uploads the file name of fsys, e.g. an embed.FS or a zip.Reader, without
+// extracting it to disk first. Files with the extension of a video format, like ".mp4",
+// are uploaded as video. The base name of the file is used as name of the upload unless
+// WithName is passed.
+// returns image info, status code of the upload, error
+func (client *Client) UploadFromFS(ctx context.Context, fsys fs.FS, name string, opts ...UploadOption) (*ImageInfo, int, error) {
+	if fsys == nil {
+		return nil, -1, errors.New("Invalid file system")
 	}
+	source := FSSource(fsys, name)
+	source.video = uploadExtensions[strings.ToLower(path.Ext(name))]
+	return client.Upload(ctx, source, opts...)
+}
 
-	req.Header.Add("Authorization", "Client-ID "+client.imgurAccount.clientID)
-	req.Header.Add("Content-Type", writer.FormDataContentType())
-	if client.rapidAPIKey != "" {
-		req.Header.Add("X-RapidAPI-Key", client.rapidAPIKey)
+// UploadImageBase64 uploads the image data raw base64 encoded, which is encoded by this
+// method. If raw is a data URI like "data:image/png;base64,iVBOR...", its base64 data is sent.
+// returns image info, status code of the upload, error
+func (client *Client) UploadImageBase64(ctx context.Context, raw []byte, opts ...UploadOption) (*ImageInfo, int, error) {
+	if len(raw) == 0 {
+		return nil, -1, errors.New("Invalid image")
+	}
+	if data, ok := dataURIBase64(raw); ok {
+		return client.Upload(ctx, Base64Source(data), opts...)
 	}
+	return client.Upload(ctx, Base64Source(base64.StdEncoding.EncodeToString(raw)), opts...)
+}
+
+// dataURIBase64 returns the data of the base64 encoded data URI raw
+func dataURIBase64(raw []byte) (string, bool) {
+	if len(raw) < 5 || !strings.EqualFold(string(raw[:5]), "data:") {
+		return "", false
+	}
+	comma := bytes.IndexByte(raw, ',')
+	if comma < 0 || !strings.HasSuffix(strings.ToLower(string(raw[:comma])), ";base64") {
+		return "", false
+	}
+	return strings.TrimSpace(string(raw[comma+1:])), true
+}
+
+// uploadStream streams the file of source as the form file named field to the given upload endpoint
+func (client *Client) uploadStream(ctx context.Context, endpoint string, field string, source UploadSource, o *uploadOptions) (*ImageInfo, int, error) {
+	writer := multipart.NewWriter(nil)
+	boundary := writer.Boundary()
 
-	res, err := client.httpClient.Do(req)
+	body, err := streamUploadForm(field, source, boundary, o)
 	if err != nil {
-		return nil, -1, errors.New("Could not post " + URL + " - " + err.Error())
+		return nil, -1, err
 	}
-	defer res.Body.Close()
+	defer body.Close()
 
-	// Read the whole body
-	body, err := io.ReadAll(res.Body)
+	// only sources that can be opened again can be sent again when a request is retried
+	var getBody func() (io.ReadCloser, error)
+	if source.replayable {
+		getBody = func() (io.ReadCloser, error) {
+			return streamUploadForm(field, source, boundary, o)
+		}
+	}
+
+	return client.postUpload(ctx, endpoint, body, getBody, writer.FormDataContentType())
+}
+
+// streamUploadForm opens source and returns a reader producing the multipart form for it.
+// The form is written by a goroutine while the reader is consumed.
+func streamUploadForm(field string, source UploadSource, boundary string, o *uploadOptions) (io.ReadCloser, error) {
+	r, size, err := source.open()
 	if err != nil {
-		return nil, -1, errors.New("Problem reading the body of " + URL + " - " + err.Error())
+		return nil, err
+	}
+	if o.validate {
+		if r, err = validateUpload(r, size, source.video, o.contentType); err != nil {
+			return nil, err
+		}
 	}
 
-	// client.Log.Debugf("%v\n", string(body[:]))
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+	if err := writer.SetBoundary(boundary); err != nil {
+		r.Close()
+		return nil, err
+	}
+	go func() {
+		err := writeFileUploadForm(writer, field, r, size, o)
+		r.Close()
+		pw.CloseWithError(err)
+	}()
 
-	dec := json.NewDecoder(bytes.NewReader(body))
-	var img imageInfoDataWrapper
-	if err = dec.Decode(&img); err != nil {
-		return nil, -1, errors.New("Problem decoding json result from image upload - " + err.Error() + ". JSON(?): " + string(body))
+	return pr, nil
+}
+
+// writeFileUploadForm writes the multipart form for an upload of binary data.
+// The metadata fields are written first so the file part is the last thing in the body.
+func writeFileUploadForm(writer *multipart.Writer, field string, r io.Reader, size int64, o *uploadOptions) error {
+	if err := writeUploadFields(writer, FileType, o); err != nil {
+		return err
 	}
 
-	if !img.Success {
-		return nil, img.Status, errors.New("Upload to imgur failed with status: " + strconv.Itoa(img.Status))
+	buf := uploadBuffers.Get().(*uploadBuffer)
+	defer uploadBuffers.Put(buf)
+	buf.head.Reset(r)
+	defer buf.head.Reset(nil)
+
+	head, err := buf.head.Peek(512)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return err
+	}
+	r = &buf.head
+	part, err := writer.CreatePart(filePartHeader(field, head, o))
+	if err != nil {
+		return err
+	}
+	if size >= 0 {
+		r = io.LimitReader(r, size)
+	}
+	// hide the WriteTo of the bufio.Reader, which would write to the part in pieces of 512 bytes
+	n, err := io.CopyBuffer(part, struct{ io.Reader }{newProgressReader(r, size, o.progress)}, buf.copy)
+	if err != nil {
+		return err
+	}
+	if size >= 0 && n != size {
+		return fmt.Errorf("Could only read %v of %v bytes of the %v", n, size, field)
 	}
 
-	img.Ii.Limit, _ = extractRateLimits(res.Header)
+	return writer.Close()
+}
 
-	return img.Ii, img.Status, nil
+// uploadBuffer are the buffers writeFileUploadForm needs for an upload
+type uploadBuffer struct {
+	head bufio.Reader // to sniff the content type from the start of the file
+	copy []byte       // to copy the file into the form
 }
 
-func createUploadForm(writer *multipart.Writer, image []byte, album string, dtype string, title string, description string) {
-	part, _ := writer.CreateFormFile("image", "image")
-	_, _ = part.Write(image)
+// uploadBuffers keeps the buffers of finished uploads for the next ones
+var uploadBuffers = sync.Pool{
+	New: func() interface{} {
+		return &uploadBuffer{head: *bufio.NewReaderSize(nil, 512), copy: make([]byte, 32<<10)}
+	},
+}
 
-	_ = writer.WriteField("image", string(image[:]))
-	_ = writer.WriteField("type", dtype)
+// filePartHeader returns the header of the form file named field starting with head.
+// Its content type is sniffed from head unless set with WithContentType. The file name
+// is the name of the upload or field, with the extension of the content type if it has none.
+func filePartHeader(field string, head []byte, o *uploadOptions) textproto.MIMEHeader {
+	mimeType := o.contentType
+	if mimeType == "" {
+		mimeType = sniffType(head)
+	}
+	filename := o.name
+	if filename == "" {
+		filename = field
+	}
+	if unknownType(mimeType) {
+		mimeType = "application/octet-stream"
+	} else if path.Ext(filename) == "" {
+		filename += uploadTypes[mimeType]
+	}
+
+	h := textproto.MIMEHeader{}
+	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`, quoteEscaper.Replace(field), quoteEscaper.Replace(filename)))
+	h.Set("Content-Type", mimeType)
+	return h
+}
 
-	if album != "" {
-		_ = writer.WriteField("album", album)
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// writeUploadFields writes the given dtype and all optional parameters set in o
+func writeUploadFields(writer *multipart.Writer, dtype UploadType, o *uploadOptions) error {
+	fields := [][2]string{
+		{"type", string(dtype)},
+		{"album", o.album},
+		{"title", o.title},
+		{"description", o.description},
+		{"name", o.name},
 	}
-	if title != "" {
-		_ = writer.WriteField("title", title)
+	if o.disableAudio {
+		fields = append(fields, [2]string{"disable_audio", "1"})
 	}
-	if description != "" {
-		_ = writer.WriteField("description", description)
+	for _, field := range fields {
+		if field[1] == "" {
+			continue
+		}
+		if err := writer.WriteField(field[0], field[1]); err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
-// UploadImageFromFile uploads a file given by the filename string to imgur.
-func (client *Client) UploadImageFromFile(filename string, album string, title string, description string) (*ImageInfo, int, error) {
-	client.Log.Infof("*** IMAGE UPLOAD ***\n")
-	f, err := os.Open(filename)
+// postUpload sends a multipart upload body to the given imgur endpoint.
+// getBody is optional and used to recreate the body when the upload is retried.
+// returns image info, status code of the upload, error
+func (client *Client) postUpload(ctx context.Context, endpoint string, reqbody io.Reader, getBody func() (io.ReadCloser, error), contentType string) (*ImageInfo, int, error) {
+	URL := client.createAPIURL(endpoint)
+	req, err := client.newRequest(ctx, "POST", URL, reqbody)
+	client.Log.Debugf("Posting to URL %v\n", URL)
 	if err != nil {
-		return nil, 500, fmt.Errorf("Could not open file %v - Error: %v", filename, err)
+		return nil, -1, fmt.Errorf("Could create request for %v - %w", URL, err)
+	}
+	if getBody != nil {
+		req.GetBody = getBody
 	}
-	defer f.Close()
-	fileinfo, err := f.Stat()
+
+	req.Header.Set("Content-Type", contentType)
+
+	res, err := client.do(req)
+	if err != nil {
+		return nil, -1, fmt.Errorf("Could not post %v - %w", URL, err)
+	}
+	defer res.Body.Close()
+
+	// Read the whole body
+	body, err := client.readBody(res.Body)
 	if err != nil {
-		return nil, 500, fmt.Errorf("Could not stat file %v - Error: %v", filename, err)
+		return nil, -1, fmt.Errorf("Problem reading the body of %v - %w", URL, err)
+	}
+
+	// client.Log.Debugf("%v\n", string(body[:]))
+
+	if !(res.StatusCode >= 200 && res.StatusCode <= 300) || isErrorPage(body) {
+		return nil, res.StatusCode, fmt.Errorf("Upload to imgur failed - %w", NewAPIError(req.Method, URL, res.StatusCode, body))
 	}
-	size := fileinfo.Size()
-	b := make([]byte, size)
-	n, err := f.Read(b)
-	if err != nil || int64(n) != size {
-		return nil, 500, fmt.Errorf("Could not read file %v - Error: %v", filename, err)
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	var img imageInfoDataWrapper
+	if err = dec.Decode(&img); err != nil {
+		return nil, -1, fmt.Errorf("Problem decoding json result from image upload - %w", err)
+	}
+
+	if !img.Success {
+		return nil, img.Status, fmt.Errorf("Upload to imgur failed - %w", NewAPIError(req.Method, URL, img.Status, body))
 	}
 
-	return client.UploadImage(b, album, "file", title, description)
+	img.Ii.Limit, _ = extractRateLimits(res.Header)
+	client.keepRawJSON(body, img.Ii)
+
+	return img.Ii, img.Status, nil
+}
+
+// UploadImageFromFile uploads a file given by the filename string to imgur.
+func (client *Client) UploadImageFromFile(filename string, album string, title string, description string) (*ImageInfo, int, error) {
+	return client.UploadImageFromFileWithContext(context.Background(), filename, album, title, description)
+}
+
+// UploadImageFromFileWithContext is like UploadImageFromFile, but the upload is bound to ctx.
+// The file is streamed into the request and is never held in memory as a whole.
+func (client *Client) UploadImageFromFileWithContext(ctx context.Context, filename string, album string, title string, description string) (*ImageInfo, int, error) {
+	client.Log.Infof("*** IMAGE UPLOAD ***\n")
+	return client.Upload(ctx, FileSource(filename), WithAlbum(AlbumID(album)), WithTitle(title), WithDescription(description))
 }
@@ -1,9 +1,21 @@
 package imgur
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"mime/multipart"
 	"net/http"
 	"os"
+	"path"
+	"strings"
 	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -85,3 +97,228 @@ func TestUploadImageSimulated(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestUploadImageFromReaderSimulated(t *testing.T) {
+	image := []byte("not really a jpeg")
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseMultipartForm(1024))
+		require.Equal(t, "file", r.FormValue("type"))
+		require.Equal(t, "ALBUMID", r.FormValue("album"))
+		require.Equal(t, title, r.FormValue("title"))
+		require.Equal(t, descr, r.FormValue("description"))
+		require.Len(t, r.MultipartForm.Value["image"], 0)
+
+		f, _, err := r.FormFile("image")
+		require.NoError(t, err)
+		b, err := io.ReadAll(f)
+		require.NoError(t, err)
+		require.Equal(t, image, b)
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data":{"id":"ClF8rLe","title":"`+title+`","description":"`+descr+`"},"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	ii, status, err := client.UploadImageFromReader(context.Background(), bytes.NewReader(image), int64(len(image)),
+		WithAlbum(AlbumID("ALBUMID")), WithTitle(title), WithDescription(descr))
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Equal(t, ImageID("ClF8rLe"), ii.ID)
+	require.Equal(t, title, ii.Title)
+}
+
+func TestUploadImageFromReaderShortRead(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.ReadAll(r.Body)
+		fmt.Fprint(w, `{"data":{"id":"ClF8rLe"},"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	_, _, err := client.UploadImageFromReader(context.Background(), strings.NewReader("short"), 100)
+	require.Error(t, err)
+
+	_, _, err = client.UploadImageFromReader(context.Background(), nil, 100)
+	require.Error(t, err)
+}
+
+func TestUploadImageFromReaderProgress(t *testing.T) {
+	httpC, server := testHTTPClientJSON(`{"data":{"id":"ClF8rLe"},"success":true,"status":200}`)
+	defer server.Close()
+
+	image := bytes.Repeat([]byte("x"), 100000)
+	var calls int
+	var lastSent, lastTotal int64
+	progress := func(sent, total int64) {
+		require.True(t, sent > lastSent)
+		calls++
+		lastSent, lastTotal = sent, total
+	}
+
+	client, _ := NewClient(httpC, "testing", "")
+	_, _, err := client.UploadImageFromReader(context.Background(), bytes.NewReader(image), int64(len(image)), WithProgress(progress))
+	require.NoError(t, err)
+	require.True(t, calls > 0)
+	require.Equal(t, int64(len(image)), lastSent)
+	require.Equal(t, int64(len(image)), lastTotal)
+}
+
+type testFormPart struct {
+	name     string
+	fileName string
+	content  string
+}
+
+func parseTestForm(t *testing.T, body []byte, contentType string) []testFormPart {
+	_, params, err := mime.ParseMediaType(contentType)
+	require.NoError(t, err)
+
+	var parts []testFormPart
+	reader := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return parts
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(part)
+		require.NoError(t, err)
+		parts = append(parts, testFormPart{part.FormName(), part.FileName(), string(content)})
+	}
+}
+
+func TestUploadFormStructure(t *testing.T) {
+	binary := "\x00\xff\xd8binary"
+	o := &uploadOptions{title: title, album: "ALBUMID"}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	require.NoError(t, writeFileUploadForm(writer, "image", strings.NewReader(binary), int64(len(binary)), o))
+	require.Equal(t, []testFormPart{
+		{"type", "", "file"},
+		{"album", "", "ALBUMID"},
+		{"title", "", title},
+		{"image", "image", binary},
+	}, parseTestForm(t, body.Bytes(), writer.FormDataContentType()))
+
+	for _, dtype := range []UploadType{Base64Type, URLType} {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		require.NoError(t, writeValueUploadForm(writer, dtype, "value", o))
+		require.Equal(t, []testFormPart{
+			{"type", "", string(dtype)},
+			{"album", "", "ALBUMID"},
+			{"title", "", title},
+			{"image", "", "value"},
+		}, parseTestForm(t, body.Bytes(), writer.FormDataContentType()))
+	}
+}
+
+func TestUploadImageDtypes(t *testing.T) {
+	for _, dtype := range []UploadType{FileType, Base64Type, URLType} {
+		httpC, closeServer := testUploadServer(t, func(r *http.Request) {
+			require.Equal(t, string(dtype), r.FormValue("type"))
+			if dtype == FileType {
+				require.Len(t, r.MultipartForm.Value["image"], 0)
+				require.Equal(t, "https://example.com/cat.jpg", formFile(t, r, "image"))
+			} else {
+				require.Len(t, r.MultipartForm.File["image"], 0)
+				require.Equal(t, "https://example.com/cat.jpg", r.FormValue("image"))
+			}
+		})
+
+		client, _ := NewClient(httpC, "testing", "")
+		_, _, err := client.UploadImage([]byte("https://example.com/cat.jpg"), "", dtype, title, descr)
+		require.NoError(t, err)
+		closeServer()
+	}
+}
+
+func TestUploadFilePart(t *testing.T) {
+	webp := "RIFF\x00\x00\x00\x00WEBPVP8 "
+	tests := []struct {
+		data        string
+		opts        []UploadOption
+		filename    string
+		contentType string
+	}{
+		{data: webp, filename: "image.webp", contentType: "image/webp"},
+		{data: "\x00\x00\x00\x18ftypmp42\x00\x00\x00\x00mp42mp41", filename: "image.mp4", contentType: "video/mp4"},
+		{data: webp, opts: []UploadOption{WithName("cat")}, filename: "cat.webp", contentType: "image/webp"},
+		{data: webp, opts: []UploadOption{WithName("cat.\"x\".png")}, filename: "cat.\"x\".png", contentType: "image/webp"},
+		{data: "placeholder", opts: []UploadOption{WithContentType("image/png")}, filename: "image.png", contentType: "image/png"},
+		{data: "placeholder", filename: "image", contentType: "application/octet-stream"},
+	}
+	for _, test := range tests {
+		httpC, closeServer := testUploadServer(t, func(r *http.Request) {
+			file := r.MultipartForm.File["image"][0]
+			require.Equal(t, test.filename, file.Filename)
+			require.Equal(t, test.contentType, file.Header.Get("Content-Type"))
+			require.Equal(t, test.data, formFile(t, r, "image"))
+		})
+		client, _ := NewClient(httpC, "testing", "")
+		_, _, err := client.Upload(context.Background(), BytesSource([]byte(test.data)), test.opts...)
+		require.NoError(t, err)
+		closeServer()
+	}
+}
+
+func TestUploadImageBase64(t *testing.T) {
+	tests := map[string]string{
+		"\x89PNG binary":                     "iVBORyBiaW5hcnk=",
+		"data:image/png;base64,iVBORw0KGgo=": "iVBORw0KGgo=",
+		"DATA:image/gif;BASE64, R0lGOD== ":   "R0lGOD==",
+		"data:text/plain,not base64":         "ZGF0YTp0ZXh0L3BsYWluLG5vdCBiYXNlNjQ=",
+	}
+	for raw, encoded := range tests {
+		httpC, closeServer := testUploadServer(t, func(r *http.Request) {
+			require.Equal(t, "base64", r.FormValue("type"))
+			require.Equal(t, encoded, r.FormValue("image"))
+			require.Equal(t, title, r.FormValue("title"))
+		})
+		client, _ := NewClient(httpC, "testing", "")
+		_, _, err := client.UploadImageBase64(context.Background(), []byte(raw), WithTitle(title))
+		require.NoError(t, err)
+		closeServer()
+	}
+
+	client, _ := NewClient(new(http.Client), "testing", "")
+	_, _, err := client.UploadImageBase64(context.Background(), nil)
+	require.Error(t, err)
+}
+
+func TestUploadFromFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"assets/cat.png":  {Data: pngHeader},
+		"assets/clip.MP4": {Data: []byte("video")},
+		"assets/old.mpeg": {Data: []byte("video")},
+	}
+	tests := []struct {
+		name  string
+		path  string
+		field string
+		data  string
+	}{
+		{"assets/cat.png", "/3/image", "image", string(pngHeader)},
+		{"assets/clip.MP4", "/3/upload", "video", "video"},
+		{"assets/old.mpeg", "/3/upload", "video", "video"},
+	}
+	for _, test := range tests {
+		httpC, closeServer := testUploadServer(t, func(r *http.Request) {
+			require.Equal(t, test.path, r.URL.Path)
+			require.Equal(t, path.Base(test.name), r.FormValue("name"))
+			require.Equal(t, test.data, formFile(t, r, test.field))
+		})
+		client, _ := NewClient(httpC, "testing", "")
+		_, _, err := client.UploadFromFS(context.Background(), fsys, test.name)
+		require.NoError(t, err)
+		closeServer()
+	}
+
+	client, _ := NewClient(new(http.Client), "testing", "")
+	_, _, err := client.UploadFromFS(context.Background(), fsys, "assets/missing.png")
+	require.ErrorIs(t, err, fs.ErrNotExist)
+	_, _, err = client.UploadFromFS(context.Background(), nil, "cat.png")
+	require.Error(t, err)
+}
@@ -0,0 +1,76 @@
+package imgur
+
+// UploadOption sets an optional parameter of an upload
+type UploadOption func(*uploadOptions)
+
+// uploadOptions collects all optional upload parameters
+type uploadOptions struct {
+	album       string
+	title       string
+	description string
+	name        string
+	contentType string
+	progress    ProgressFunc
+
+	disableAudio bool
+	validate     bool // check the data before it is sent, see WithUploadValidation
+}
+
+func newUploadOptions(source UploadSource, opts []UploadOption) *uploadOptions {
+	o := &uploadOptions{name: source.name}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithAlbum adds the uploaded image to an album.
+// For anonymous albums, album should be the DeleteHash that is returned at creation.
+func WithAlbum(album AlbumRef) UploadOption {
+	return func(o *uploadOptions) {
+		o.album = refString(album)
+	}
+}
+
+// WithTitle sets the title of the uploaded image.
+func WithTitle(title string) UploadOption {
+	return func(o *uploadOptions) {
+		o.title = title
+	}
+}
+
+// WithDescription sets the description of the uploaded image.
+func WithDescription(description string) UploadOption {
+	return func(o *uploadOptions) {
+		o.description = description
+	}
+}
+
+// WithName sets the file name imgur stores for the upload.
+func WithName(name string) UploadOption {
+	return func(o *uploadOptions) {
+		o.name = name
+	}
+}
+
+// WithContentType sets the MIME type of the uploaded file, e.g. "image/webp". By default
+// it is sniffed from the data. It has no effect on URL and base64 uploads.
+func WithContentType(mimeType string) UploadOption {
+	return func(o *uploadOptions) {
+		o.contentType = mimeType
+	}
+}
+
+// WithProgress registers a callback that is informed about the upload progress.
+func WithProgress(progress ProgressFunc) UploadOption {
+	return func(o *uploadOptions) {
+		o.progress = progress
+	}
+}
+
+// WithDisableAudio removes the audio track from an uploaded video.
+func WithDisableAudio() UploadOption {
+	return func(o *uploadOptions) {
+		o.disableAudio = true
+	}
+}
@@ -0,0 +1,42 @@
+package imgur
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UploadType is the type of the data sent with UploadImage
+type UploadType string
+
+// Upload types imgur knows
+const (
+	FileType   UploadType = "file"   // Binary file data
+	Base64Type UploadType = "base64" // Base64 encoded file data
+	URLType    UploadType = "URL"    // URL imgur fetches the file from
+)
+
+// ParseUploadType returns the UploadType named s, e.g. in a configuration file.
+// The case of s is ignored, so "url" is URLType.
+func ParseUploadType(s string) (UploadType, error) {
+	for _, t := range []UploadType{FileType, Base64Type, URLType} {
+		if strings.EqualFold(strings.TrimSpace(s), string(t)) {
+			return t, nil
+		}
+	}
+	return "", fmt.Errorf("Invalid upload type %q. Please use file/base64/URL.", s)
+}
+
+// String returns the name imgur uses for t
+func (t UploadType) String() string {
+	return string(t)
+}
+
+// UnmarshalText parses text like ParseUploadType, e.g. when decoding a configuration
+func (t *UploadType) UnmarshalText(text []byte) error {
+	parsed, err := ParseUploadType(string(text))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
@@ -0,0 +1,37 @@
+package imgur
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUploadType(t *testing.T) {
+	for s, expected := range map[string]UploadType{"file": FileType, "BASE64": Base64Type, "url": URLType, " URL ": URLType} {
+		dtype, err := ParseUploadType(s)
+		require.NoError(t, err)
+		require.Equal(t, expected, dtype)
+	}
+	_, err := ParseUploadType("link")
+	require.Error(t, err)
+
+	var config struct {
+		Type UploadType `json:"type"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"url"}`), &config))
+	require.Equal(t, URLType, config.Type)
+	require.Error(t, json.Unmarshal([]byte(`{"type":"link"}`), &config))
+}
+
+func TestUploadImageTypeIgnoresCase(t *testing.T) {
+	httpC, closeServer := testUploadServer(t, func(r *http.Request) {
+		require.Equal(t, "URL", r.FormValue("type"))
+	})
+	defer closeServer()
+
+	client, _ := NewClient(httpC, "testing", "")
+	_, _, err := client.UploadImage([]byte("https://example.com/cat.jpg"), "", "url", title, descr)
+	require.NoError(t, err)
+}
@@ -0,0 +1,102 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// UploadImageFromURL lets imgur fetch and store the image found at imageURL.
+// Only absolute http and https URLs are accepted.
+// returns image info, status code of the upload, error
+func (client *Client) UploadImageFromURL(ctx context.Context, imageURL string, opts ...UploadOption) (*ImageInfo, int, error) {
+	return client.Upload(ctx, URLSource(imageURL), opts...)
+}
+
+// MirrorImage downloads the image or video at sourceURL and uploads it to imgur while it is
+// received, so the file is never held in memory. Unlike UploadImageFromURL this works for
+// files imgur can not fetch itself, e.g. on hosts that block imgur. The upload is not retried.
+// returns image info, status code of the upload, error
+func (client *Client) MirrorImage(ctx context.Context, sourceURL string, opts ...UploadOption) (*ImageInfo, int, error) {
+	if err := validateImageURL(sourceURL); err != nil {
+		return nil, -1, err
+	}
+	res, err := client.openFile(ctx, sourceURL, 0)
+	if err != nil {
+		return nil, -1, err
+	}
+	defer res.Body.Close()
+
+	source := ReaderSource(res.Body, res.ContentLength)
+	source.video = strings.HasPrefix(res.Header.Get("Content-Type"), "video/")
+	if name := path.Base(res.Request.URL.Path); name != "/" && name != "." {
+		source.name = name
+	}
+	return client.Upload(ctx, source, opts...)
+}
+
+// uploadValue uploads an image that is passed as a plain form field, like a URL or base64 data.
+// The form is streamed like files are, so large base64 data is not copied into a buffer.
+func (client *Client) uploadValue(ctx context.Context, dtype UploadType, value string, o *uploadOptions) (*ImageInfo, int, error) {
+	writer := multipart.NewWriter(nil)
+	boundary := writer.Boundary()
+
+	body := streamValueUploadForm(dtype, value, boundary, o)
+	defer body.Close()
+	getBody := func() (io.ReadCloser, error) {
+		return streamValueUploadForm(dtype, value, boundary, o), nil
+	}
+
+	return client.postUpload(ctx, "image", body, getBody, writer.FormDataContentType())
+}
+
+// streamValueUploadForm returns a reader producing the multipart form for value, which
+// is written by a goroutine while the reader is consumed
+func streamValueUploadForm(dtype UploadType, value string, boundary string, o *uploadOptions) io.ReadCloser {
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+	go func() {
+		err := writer.SetBoundary(boundary)
+		if err == nil {
+			err = writeValueUploadForm(writer, dtype, value, o)
+		}
+		pw.CloseWithError(err)
+	}()
+	return pr
+}
+
+// writeValueUploadForm writes the multipart form for an upload without a file part
+func writeValueUploadForm(writer *multipart.Writer, dtype UploadType, value string, o *uploadOptions) error {
+	if err := writeUploadFields(writer, dtype, o); err != nil {
+		return err
+	}
+	part, err := writer.CreateFormField("image")
+	if err != nil {
+		return err
+	}
+	// WriteField and the WriteTo of strings.Reader would copy the whole value to a []byte
+	buf := uploadBuffers.Get().(*uploadBuffer)
+	defer uploadBuffers.Put(buf)
+	if _, err := io.CopyBuffer(part, struct{ io.Reader }{strings.NewReader(value)}, buf.copy); err != nil {
+		return err
+	}
+	return writer.Close()
+}
+
+func validateImageURL(imageURL string) error {
+	u, err := url.Parse(imageURL)
+	if err != nil {
+		return fmt.Errorf("Invalid image URL %v - %w", imageURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("Invalid image URL %v - only http and https are supported", imageURL)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("Invalid image URL %v - host is missing", imageURL)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadImageFromURLSimulated(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseMultipartForm(1024))
+		require.Equal(t, "URL", r.FormValue("type"))
+		require.Equal(t, "https://example.com/cat.jpg", r.FormValue("image"))
+		require.Equal(t, title, r.FormValue("title"))
+		require.Len(t, r.MultipartForm.File, 0)
+
+		fmt.Fprint(w, `{"data":{"id":"ClF8rLe","title":"`+title+`"},"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	ii, status, err := client.UploadImageFromURL(context.Background(), "https://example.com/cat.jpg", WithTitle(title))
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Equal(t, ImageID("ClF8rLe"), ii.ID)
+}
+
+func TestUploadImageFromURLInvalid(t *testing.T) {
+	client, _ := NewClient(new(http.Client), "testing", "")
+
+	for _, u := range []string{"", "example.com/cat.jpg", "ftp://example.com/cat.jpg", "https:///cat.jpg", "://"} {
+		_, _, err := client.UploadImageFromURL(context.Background(), u)
+		require.Error(t, err, u)
+	}
+}
+
+func TestMirrorImage(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Host {
+		case "example.com":
+			w.Header().Set("Content-Type", r.URL.Query().Get("type"))
+			fmt.Fprint(w, "remote data")
+		case "api.imgur.com":
+			field := "image"
+			if r.URL.Path == "/3/upload" {
+				field = "video"
+			}
+			require.NoError(t, r.ParseMultipartForm(1024))
+			require.Equal(t, "remote data", formFile(t, r, field))
+			require.Equal(t, "cat.jpg", r.FormValue("name"))
+			require.Equal(t, title, r.FormValue("title"))
+			fmt.Fprint(w, `{"data":{"id":"ClF8rLe"},"success":true,"status":200}`)
+		default:
+			t.Errorf("Unexpected host %v", r.Host)
+		}
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	for _, contentType := range []string{"image/jpeg", "video/mp4"} {
+		ii, status, err := client.MirrorImage(context.Background(), "https://example.com/cat.jpg?type="+contentType, WithTitle(title))
+		require.NoError(t, err)
+		require.Equal(t, 200, status)
+		require.Equal(t, ImageID("ClF8rLe"), ii.ID)
+	}
+
+	_, _, err := client.MirrorImage(context.Background(), "ftp://example.com/cat.jpg")
+	require.Error(t, err)
+}
@@ -0,0 +1,146 @@
+package imgur
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Size limits imgur enforces for uploads
+const (
+	MaxImageSize = 10 << 20  // Largest image imgur accepts
+	MaxVideoSize = 200 << 20 // Largest video imgur accepts
+)
+
+// uploadFileType is a file type imgur accepts for uploads
+type uploadFileType struct {
+	ext      string // File extension
+	mimeType string
+	video    bool
+}
+
+// uploadFileTypes are all file types imgur accepts for uploads. The first extension of a
+// MIME type is the one imgur uses for it.
+var uploadFileTypes = []uploadFileType{
+	{".jpg", "image/jpeg", false},
+	{".jpeg", "image/jpeg", false},
+	{".png", "image/png", false},
+	{".apng", "image/png", false},
+	{".gif", "image/gif", false},
+	{".tiff", "image/tiff", false},
+	{".tif", "image/tiff", false},
+	{".webp", "image/webp", false},
+	{".bmp", "image/bmp", false},
+	{".mp4", "video/mp4", true},
+	{".webm", "video/webm", true},
+	{".mkv", "video/x-matroska", true},
+	{".mov", "video/quicktime", true},
+	{".avi", "video/x-msvideo", true},
+	{".avi", "video/avi", true},
+	{".mpg", "video/mpeg", true},
+	{".mpeg", "video/mpeg", true},
+	{".flv", "video/x-flv", true},
+	{".wmv", "video/x-ms-wmv", true},
+}
+
+// uploadTypes are the MIME types of uploadFileTypes and their file extensions,
+// uploadExtensions the file extensions and if they are videos
+var uploadTypes, uploadExtensions = uploadTypeLookups()
+
+func uploadTypeLookups() (map[string]string, map[string]bool) {
+	types := map[string]string{}
+	extensions := map[string]bool{}
+	for _, t := range uploadFileTypes {
+		if _, ok := types[t.mimeType]; !ok {
+			types[t.mimeType] = t.ext
+		}
+		extensions[t.ext] = t.video
+	}
+	return types, extensions
+}
+
+// WithUploadValidation sets if the data of uploads is checked before it is sent, which
+// is the default. The format is sniffed from the start of the data and uploads in a format
+// imgur does not accept fail with ErrUnsupportedFormat. Images larger than MaxImageSize and
+// videos larger than MaxVideoSize fail with ErrUploadTooLarge, if the size is unknown once
+// that much was read. Data in an unknown format is sent and left to imgur, so are URL and
+// base64 uploads. Disable it if imgur accepts more than the client knows about.
+func WithUploadValidation(enabled bool) ClientOption {
+	return func(c *Client) {
+		c.skipUploadValidation = !enabled
+	}
+}
+
+// validateUpload checks the data of r, which has size bytes or -1 if unknown, before it
+// is uploaded. The returned reader has to be read instead of r, closing it closes r.
+// r is closed if the upload is rejected.
+// video is set for video uploads, data of an unknown format is limited like a video then.
+// The format is sniffed unless mimeType is given, e.g. with WithContentType.
+func validateUpload(r io.ReadCloser, size int64, video bool, mimeType string) (io.ReadCloser, error) {
+	br := bufio.NewReaderSize(r, 512)
+	head, err := br.Peek(512)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		r.Close()
+		return nil, fmt.Errorf("Could not read the upload - %w", err)
+	}
+
+	if mimeType == "" {
+		mimeType = sniffType(head)
+	}
+	limit := int64(MaxImageSize)
+	switch {
+	case uploadTypes[mimeType] != "":
+		if strings.HasPrefix(mimeType, "video/") {
+			limit = MaxVideoSize
+		}
+	case unknownType(mimeType):
+		// not recognized, imgur might know the format
+		if video {
+			limit = MaxVideoSize
+		}
+	default:
+		r.Close()
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedFormat, mimeType)
+	}
+
+	if size > limit {
+		r.Close()
+		return nil, fmt.Errorf("%w: %v bytes of %v, at most %v bytes are allowed", ErrUploadTooLarge, size, mimeType, limit)
+	}
+	limited := &limitedReader{r: br, remaining: limit, limit: limit, err: ErrUploadTooLarge}
+	return struct {
+		io.Reader
+		io.Closer
+	}{limited, r}, nil
+}
+
+// sniffType returns the MIME type of data, like http.DetectContentType, but it also
+// recognizes some formats imgur accepts that http.DetectContentType does not know
+func sniffType(data []byte) string {
+	switch {
+	case bytes.HasPrefix(data, []byte("II*\x00")), bytes.HasPrefix(data, []byte("MM\x00*")):
+		return "image/tiff"
+	case len(data) >= 12 && string(data[4:8]) == "ftyp" && string(data[8:12]) == "qt  ":
+		return "video/quicktime"
+	case bytes.HasPrefix(data, []byte("\x00\x00\x01\xba")), bytes.HasPrefix(data, []byte("\x00\x00\x01\xb3")):
+		return "video/mpeg"
+	case bytes.HasPrefix(data, []byte("FLV\x01")):
+		return "video/x-flv"
+	case bytes.HasPrefix(data, []byte("\x30\x26\xb2\x75\x8e\x66\xcf\x11")):
+		return "video/x-ms-wmv"
+	}
+	mimeType := http.DetectContentType(data)
+	if mimeType == "application/octet-stream" && len(data) >= 8 && string(data[4:8]) == "ftyp" {
+		// ISO media with a brand http.DetectContentType does not check for, e.g. isom
+		return "video/mp4"
+	}
+	return mimeType
+}
+
+// unknownType reports if mimeType was sniffed from data in no known format
+func unknownType(mimeType string) bool {
+	return mimeType == "application/octet-stream" || strings.HasPrefix(mimeType, "text/plain")
+}
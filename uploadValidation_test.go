@@ -0,0 +1,80 @@
+package imgur
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var pngHeader = []byte("\x89PNG\x0d\x0a\x1a\x0a")
+
+func TestSniffType(t *testing.T) {
+	tests := map[string]string{
+		string(pngHeader):  "image/png",
+		"GIF89a...":        "image/gif",
+		"\xff\xd8\xff\xe0": "image/jpeg",
+		"II*\x00":          "image/tiff",
+		"\x00\x00\x00\x14ftypqt  \x00\x00\x00\x00":         "video/quicktime",
+		"\x00\x00\x00\x18ftypmp42\x00\x00\x00\x00mp42mp41": "video/mp4",
+		"\x00\x00\x00\x18ftypisom\x00\x00\x02\x00iso2avc1": "video/mp4",
+		"\x1a\x45\xdf\xa3": "video/webm",
+		"%PDF-1.4":         "application/pdf",
+	}
+	for data, mimeType := range tests {
+		require.Equal(t, mimeType, sniffType([]byte(data)), "%q", data)
+	}
+}
+
+func TestUploadValidation(t *testing.T) {
+	var requests int
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, err := io.Copy(io.Discard, r.Body)
+		if err != nil {
+			return
+		}
+		fmt.Fprint(w, `{"data":{"id":"ClF8rLe"},"success":true,"status":200}`)
+	})
+	defer server.Close()
+	client, _ := NewClient(httpC, "testing", "", WithRetryPolicy(testRetryPolicy))
+	ctx := context.Background()
+
+	_, _, err := client.Upload(ctx, BytesSource([]byte("%PDF-1.4 document")))
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+	_, _, err = client.Upload(ctx, ReaderSource(bytes.NewReader(pngHeader), MaxImageSize+1))
+	require.ErrorIs(t, err, ErrUploadTooLarge)
+	require.Equal(t, 0, requests)
+
+	// a video may be larger than an image, even if it is uploaded as image
+	mp4 := []byte("\x00\x00\x00\x18ftypmp42\x00\x00\x00\x00mp42mp41")
+	_, _, err = client.Upload(ctx, ReaderSource(io.MultiReader(bytes.NewReader(mp4), io.LimitReader(zeros{}, MaxImageSize)), int64(len(mp4))+MaxImageSize))
+	require.NoError(t, err)
+	require.Equal(t, 1, requests)
+
+	// uploads of unknown size fail once they exceed the limit
+	_, _, err = client.Upload(ctx, ReaderSource(io.MultiReader(bytes.NewReader(pngHeader), zeros{}), -1))
+	require.ErrorIs(t, err, ErrUploadTooLarge)
+
+	// data of an unknown format is left to imgur
+	_, _, err = client.Upload(ctx, BytesSource([]byte("placeholder")))
+	require.NoError(t, err)
+
+	client, _ = NewClient(httpC, "testing", "", WithUploadValidation(false))
+	_, _, err = client.Upload(ctx, BytesSource([]byte("%PDF-1.4 document")))
+	require.NoError(t, err)
+}
+
+// zeros is an endless reader of zero bytes
+type zeros struct{}
+
+func (zeros) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
@@ -0,0 +1,22 @@
+package imgur
+
+import (
+	"context"
+	"io"
+)
+
+// UploadVideo uploads a mp4 or webm video to imgur. (up to 200MB)
+// imgur converts the video after the upload finished. The returned image info
+// reports the state of the conversion in Processing; links to the video are
+// not usable before it is completed.
+// size is the number of bytes that will be read from r, pass -1 if it is unknown.
+// returns image info, status code of the upload, error
+func (client *Client) UploadVideo(ctx context.Context, r io.Reader, size int64, opts ...UploadOption) (*ImageInfo, int, error) {
+	return client.Upload(ctx, VideoReaderSource(r, size), opts...)
+}
+
+// UploadVideoFromFile uploads the video file given by the filename string to imgur.
+func (client *Client) UploadVideoFromFile(ctx context.Context, filename string, opts ...UploadOption) (*ImageInfo, int, error) {
+	client.Log.Infof("*** VIDEO UPLOAD ***\n")
+	return client.Upload(ctx, VideoFileSource(filename), opts...)
+}
@@ -0,0 +1,54 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadVideoSimulated(t *testing.T) {
+	video := "not really a mp4"
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "POST", r.Method)
+		require.Equal(t, "/3/upload", r.URL.Path)
+		require.NoError(t, r.ParseMultipartForm(1024))
+		require.Equal(t, "1", r.FormValue("disable_audio"))
+		require.Equal(t, title, r.FormValue("title"))
+
+		f, _, err := r.FormFile("video")
+		require.NoError(t, err)
+		b, err := io.ReadAll(f)
+		require.NoError(t, err)
+		require.Equal(t, video, string(b))
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data":{"id":"xZ3bTd2","type":"video/mp4","processing":{"status":"pending"}},"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	ii, status, err := client.UploadVideo(context.Background(), strings.NewReader(video), int64(len(video)), WithTitle(title), WithDisableAudio())
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.Equal(t, ImageID("xZ3bTd2"), ii.ID)
+	require.NotNil(t, ii.Processing)
+	require.Equal(t, ProcessingPending, ii.Processing.Status)
+}
+
+func TestUploadVideoErrors(t *testing.T) {
+	httpC, server := testHTTPClientJSON("")
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+
+	_, _, err := client.UploadVideo(context.Background(), nil, 0)
+	require.Error(t, err)
+
+	_, _, err = client.UploadVideoFromFile(context.Background(), "notExistingFile.youtcantseeme")
+	require.Error(t, err)
+}
@@ -0,0 +1,192 @@
+package imgur
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testUploadServer answers every upload and hands the parsed form to check
+func testUploadServer(t *testing.T, check func(r *http.Request)) (*http.Client, func()) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		check(r)
+		fmt.Fprint(w, `{"data":{"id":"ClF8rLe"},"success":true,"status":200}`)
+	})
+	return httpC, server.Close
+}
+
+func formFile(t *testing.T, r *http.Request, name string) string {
+	f, _, err := r.FormFile(name)
+	require.NoError(t, err)
+	b, err := io.ReadAll(f)
+	require.NoError(t, err)
+	return string(b)
+}
+
+func TestUploadSources(t *testing.T) {
+	testImage, err := os.ReadFile("test_data/testImage.jpg")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		source UploadSource
+		opts   []UploadOption
+		check  func(r *http.Request)
+	}{
+		{
+			name:   "bytes",
+			source: BytesSource([]byte("bytes")),
+			opts:   []UploadOption{WithTitle(title), WithDescription(descr), WithAlbum(AlbumID("ALBUMID")), WithName("cat.jpg")},
+			check: func(r *http.Request) {
+				require.Equal(t, "/3/image", r.URL.Path)
+				require.Equal(t, "file", r.FormValue("type"))
+				require.Equal(t, title, r.FormValue("title"))
+				require.Equal(t, descr, r.FormValue("description"))
+				require.Equal(t, "ALBUMID", r.FormValue("album"))
+				require.Equal(t, "cat.jpg", r.FormValue("name"))
+				require.Equal(t, "bytes", formFile(t, r, "image"))
+			},
+		},
+		{
+			name:   "reader",
+			source: ReaderSource(strings.NewReader("reader"), -1),
+			check: func(r *http.Request) {
+				require.Equal(t, "", r.FormValue("name"))
+				require.Equal(t, "reader", formFile(t, r, "image"))
+			},
+		},
+		{
+			name:   "file",
+			source: FileSource("test_data/testImage.jpg"),
+			check: func(r *http.Request) {
+				require.Equal(t, "testImage.jpg", r.FormValue("name"))
+				require.Equal(t, string(testImage), formFile(t, r, "image"))
+			},
+		},
+		{
+			name:   "file with name",
+			source: FileSource("test_data/testImage.jpg"),
+			opts:   []UploadOption{WithName("other.jpg")},
+			check: func(r *http.Request) {
+				require.Equal(t, "other.jpg", r.FormValue("name"))
+			},
+		},
+		{
+			name:   "base64",
+			source: Base64Source("aW1hZ2U="),
+			check: func(r *http.Request) {
+				require.Equal(t, "base64", r.FormValue("type"))
+				require.Equal(t, "aW1hZ2U=", r.FormValue("image"))
+			},
+		},
+		{
+			name:   "url",
+			source: URLSource("https://example.com/cat.jpg"),
+			check: func(r *http.Request) {
+				require.Equal(t, "URL", r.FormValue("type"))
+				require.Equal(t, "https://example.com/cat.jpg", r.FormValue("image"))
+			},
+		},
+		{
+			name:   "video",
+			source: VideoReaderSource(strings.NewReader("video"), 5),
+			opts:   []UploadOption{WithDisableAudio()},
+			check: func(r *http.Request) {
+				require.Equal(t, "/3/upload", r.URL.Path)
+				require.Equal(t, "1", r.FormValue("disable_audio"))
+				require.Equal(t, "video", formFile(t, r, "video"))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			httpC, closeServer := testUploadServer(t, test.check)
+			defer closeServer()
+
+			client, _ := NewClient(httpC, "testing", "")
+			ii, status, err := client.Upload(context.Background(), test.source, test.opts...)
+			require.NoError(t, err)
+			require.Equal(t, 200, status)
+			require.Equal(t, ImageID("ClF8rLe"), ii.ID)
+		})
+	}
+}
+
+func TestUploadInvalidSources(t *testing.T) {
+	client, _ := NewClient(new(http.Client), "testing", "")
+
+	for _, source := range []UploadSource{
+		{},
+		BytesSource(nil),
+		ReaderSource(nil, 0),
+		FileSource("notExistingFile.youtcantseeme"),
+		Base64Source(""),
+		URLSource("not a url"),
+	} {
+		_, _, err := client.Upload(context.Background(), source)
+		require.Error(t, err)
+	}
+}
+
+// discardUploads is an http.Client that reads and discards the uploads sent to it
+func discardUploads() *http.Client {
+	return &http.Client{Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		_, err := io.Copy(io.Discard, r.Body)
+		r.Body.Close()
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"data":{"id":"ClF8rLe"},"success":true,"status":200}`)),
+			Request:    r,
+		}, err
+	})}
+}
+
+func benchmarkUpload(b *testing.B, size int64, source func() UploadSource) {
+	client, _ := New("testing", WithHTTPClient(discardUploads()))
+	b.ReportAllocs()
+	b.SetBytes(size)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := client.Upload(context.Background(), source()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUploadBytes(b *testing.B) {
+	data := append(append([]byte{}, pngHeader...), make([]byte, 1<<20)...)
+	benchmarkUpload(b, int64(len(data)), func() UploadSource { return BytesSource(data) })
+}
+
+func BenchmarkUploadBase64(b *testing.B) {
+	data := base64.StdEncoding.EncodeToString(append(append([]byte{}, pngHeader...), make([]byte, 1<<20)...))
+	benchmarkUpload(b, int64(len(data)), func() UploadSource { return Base64Source(data) })
+}
+
+func BenchmarkUploadImageFromFile(b *testing.B) {
+	name := filepath.Join(b.TempDir(), "image.png")
+	data := append(append([]byte{}, pngHeader...), make([]byte, 1<<20)...)
+	if err := os.WriteFile(name, data, 0644); err != nil {
+		b.Fatal(err)
+	}
+	client, _ := New("testing", WithHTTPClient(discardUploads()))
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := client.UploadImageFromFile(name, "", "title", "description"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package imgur
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// CredentialProblem is why VerifyCredentials rejected the credentials of a client
+type CredentialProblem int
+
+const (
+	CredentialsValid   CredentialProblem = iota // The credentials were accepted
+	InvalidClientID                             // imgur does not know the client ID or blocked it
+	InvalidAccessToken                          // The access token expired or was revoked and could not be refreshed
+	InvalidRapidAPIKey                          // RapidAPI rejected the key, e.g. as it is not subscribed to the imgur API
+)
+
+func (p CredentialProblem) String() string {
+	switch p {
+	case CredentialsValid:
+		return "credentials valid"
+	case InvalidClientID:
+		return "invalid client ID"
+	case InvalidAccessToken:
+		return "invalid access token"
+	case InvalidRapidAPIKey:
+		return "invalid RapidAPI key"
+	}
+	return "unknown credential problem"
+}
+
+// Verification is the result of VerifyCredentials
+type Verification struct {
+	Problem  CredentialProblem // Why the credentials were rejected, CredentialsValid if they were not
+	Err      error             // The error the credentials were rejected with, nil if they are valid
+	User     bool              // If the client acts on behalf of a user
+	Username string            // The name of the user, if the credentials are valid
+	Credits  *RateLimit        // The credits of the client, if the credentials are valid and imgur sent them
+}
+
+// OK reports whether the credentials are valid
+func (v *Verification) OK() bool {
+	return v.Problem == CredentialsValid
+}
+
+// VerifyCredentials checks the credentials of the client with a single cheap request, so
+// misconfigured applications can fail on startup instead of on their first real request.
+// Clients of a user request GET /account/me, which checks the access token as well, others GET /credits.
+// A rejection of the credentials is reported in the Problem of the verification, the error
+// is only set if they could not be verified, e.g. as imgur is not reachable.
+// returns the verification, status code of the request, error
+func (client *Client) VerifyCredentials(ctx context.Context) (*Verification, int, error) {
+	v := &Verification{User: client.authenticated() && !isAnonymous(ctx)}
+
+	var status int
+	var err error
+	if v.User {
+		var account *Account
+		if account, status, err = client.GetAccount(ctx, Me); err == nil {
+			v.Username = account.URL
+			v.Credits = account.Limit
+		}
+	} else {
+		v.Credits, status, err = client.GetCredits(ctx)
+	}
+	if err == nil {
+		return v, status, nil
+	}
+
+	v.Problem = client.credentialProblem(v.User, err)
+	if v.Problem == CredentialsValid {
+		return nil, status, err
+	}
+	v.Credits = nil
+	v.Err = err
+	return v, status, nil
+}
+
+// credentialProblem returns which credentials caused err, CredentialsValid if the error
+// is not a rejection of the credentials
+func (client *Client) credentialProblem(user bool, err error) CredentialProblem {
+	var te *tokenError
+	if errors.As(err, &te) {
+		return InvalidAccessToken
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || !errors.Is(apiErr, ErrUnauthorized) {
+		return CredentialsValid
+	}
+
+	msg := strings.ToLower(apiErr.Message)
+	switch {
+	case client.rapidAPIKey != "" && (strings.Contains(msg, "api key") || strings.Contains(msg, "rapidapi") || strings.Contains(msg, "subscribed")):
+		return InvalidRapidAPIKey
+	case strings.Contains(msg, "client_id") || strings.Contains(msg, "client id"):
+		return InvalidClientID
+	case user:
+		return InvalidAccessToken
+	}
+	return InvalidClientID
+}
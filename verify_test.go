@@ -0,0 +1,109 @@
+package imgur
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyCredentials(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		switch {
+		case r.Header.Get("x-rapidapi-key") == "wrong":
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message":"You are not subscribed to this API."}`)
+		case auth == "Client-ID wrong":
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"data":{"error":"Invalid client_id","request":"\/3\/credits","method":"GET"},"success":false,"status":403}`)
+		case auth == "Bearer expired":
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"data":{"error":"The access token provided is invalid.","request":"\/3\/account\/me","method":"GET"},"success":false,"status":403}`)
+		case r.URL.Path == "/3/credits":
+			fmt.Fprint(w, `{"data":{"UserLimit":500,"UserRemaining":499,"UserReset":1460715031,"ClientLimit":12500,"ClientRemaining":12000},"success":true,"status":200}`)
+		case r.URL.Path == "/3/account/me":
+			require.Equal(t, "Bearer access", auth)
+			fmt.Fprint(w, `{"data":{"id":7,"url":"self"},"success":true,"status":200}`)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	v, status, err := client.VerifyCredentials(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 200, status)
+	require.True(t, v.OK())
+	require.False(t, v.User)
+	require.Equal(t, int64(12000), v.Credits.ClientRemaining)
+
+	user, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	v, _, err = user.VerifyCredentials(context.Background())
+	require.NoError(t, err)
+	require.True(t, v.OK())
+	require.True(t, v.User)
+	require.Equal(t, "self", v.Username)
+
+	badClientID, _ := NewClient(httpC, "wrong", "")
+	expired, _ := NewClient(httpC, "testing", "", WithAccessToken("expired"))
+	badRapidAPIKey, _ := NewClient(httpC, "testing", "wrong")
+	tests := []struct {
+		name    string
+		client  *Client
+		problem CredentialProblem
+	}{
+		{"client ID", badClientID, InvalidClientID},
+		{"access token", expired, InvalidAccessToken},
+		{"RapidAPI key", badRapidAPIKey, InvalidRapidAPIKey},
+	}
+	for _, test := range tests {
+		v, status, err := test.client.VerifyCredentials(context.Background())
+		require.NoError(t, err, test.name)
+		require.Equal(t, 403, status, test.name)
+		require.False(t, v.OK(), test.name)
+		require.Equal(t, test.problem, v.Problem, test.name)
+		require.True(t, errors.Is(v.Err, ErrUnauthorized), test.name)
+		require.Nil(t, v.Credits, test.name)
+	}
+}
+
+// revokedSource is a TokenSource whose refresh token was revoked
+type revokedSource struct{}
+
+func (revokedSource) Token(ctx context.Context) (*Token, error) {
+	return &Token{AccessToken: "expired", RefreshToken: "revoked"}, nil
+}
+
+func (revokedSource) Refresh(ctx context.Context, expired *Token) (*Token, error) {
+	return nil, errors.New("refresh token revoked")
+}
+
+func TestVerifyCredentialsRefreshFails(t *testing.T) {
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"data":{"error":"Authentication required"},"success":false,"status":401}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithTokenSource(revokedSource{}))
+	v, _, err := client.VerifyCredentials(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, InvalidAccessToken, v.Problem)
+	require.Contains(t, v.Err.Error(), "refresh token revoked")
+}
+
+func TestVerifyCredentialsUnverified(t *testing.T) {
+	httpC, server := testHTTPClient500()
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+	v, _, err := client.VerifyCredentials(context.Background())
+	require.Error(t, err)
+	require.Nil(t, v)
+	require.True(t, errors.Is(err, ErrServiceUnavailable))
+}
@@ -0,0 +1,239 @@
+package imgur
+
+import (
+	"context"
+	"time"
+)
+
+// Watcher polls the notifications of the user and delivers every new notification once.
+// Failed polls are retried with exponential backoff and polling pauses while the user
+// credits are used up.
+type Watcher struct {
+	client      *Client
+	interval    time.Duration
+	maxInterval time.Duration
+	markViewed  bool
+
+	seen map[int]bool // IDs of the notifications returned by the last poll
+}
+
+// defaultPollInterval is how often a Watcher or WatchAlbum polls by default
+const defaultPollInterval = time.Minute
+
+// WatcherOption configures a Watcher
+type WatcherOption func(*Watcher)
+
+// WithPollInterval sets how often the notifications are requested. Default is one minute,
+// which is kept for intervals that are not positive.
+func WithPollInterval(interval time.Duration) WatcherOption {
+	return func(w *Watcher) {
+		if interval > 0 {
+			w.interval = interval
+		}
+	}
+}
+
+// WithMaxBackoff limits the delay between polls after failures. Default is ten minutes.
+// It is at least the poll interval.
+func WithMaxBackoff(max time.Duration) WatcherOption {
+	return func(w *Watcher) {
+		w.maxInterval = max
+	}
+}
+
+// WithMarkViewed marks delivered notifications as viewed on imgur
+func WithMarkViewed() WatcherOption {
+	return func(w *Watcher) {
+		w.markViewed = true
+	}
+}
+
+// NewWatcher creates a watcher for the notifications of the user, which requires an authenticated client.
+func (client *Client) NewWatcher(opts ...WatcherOption) *Watcher {
+	w := &Watcher{
+		client:      client,
+		interval:    defaultPollInterval,
+		maxInterval: 10 * time.Minute,
+		seen:        map[int]bool{},
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	if w.maxInterval < w.interval {
+		w.maxInterval = w.interval
+	}
+	return w
+}
+
+// Run polls until ctx is done and calls handler for every new notification.
+// It returns the error of ctx.
+func (w *Watcher) Run(ctx context.Context, handler func(Notification)) error {
+	delay := w.interval
+	for {
+		if err := w.poll(ctx, handler); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			delay *= 2
+			if delay > w.maxInterval {
+				delay = w.maxInterval
+			}
+			w.client.Log.Warningf("Polling notifications failed, retrying in %v: %v", delay, err)
+		} else {
+			delay = w.interval
+		}
+
+		wait := delay
+		if rl := w.client.LastRateLimit(); rl != nil && rl.UserLimit > 0 && rl.UserRemaining < 1 {
+			if untilReset := time.Until(rl.UserReset); untilReset > wait {
+				wait = untilReset
+			}
+		}
+		if err := sleepContext(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// Watch polls in the background until ctx is done and sends every new notification
+// on the returned channel, which is closed afterwards.
+func (w *Watcher) Watch(ctx context.Context) <-chan Notification {
+	c := make(chan Notification)
+	go func() {
+		defer close(c)
+		_ = w.Run(ctx, func(n Notification) {
+			select {
+			case c <- n:
+			case <-ctx.Done():
+			}
+		})
+	}()
+	return c
+}
+
+// poll requests the new notifications once and hands those not seen before to handler
+func (w *Watcher) poll(ctx context.Context, handler func(Notification)) error {
+	n, _, err := w.client.GetNotifications(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	all := append(append([]Notification{}, n.Replies...), n.Messages...)
+	seen := make(map[int]bool, len(all))
+	var delivered []int
+	for _, notification := range all {
+		seen[notification.ID] = true
+		if w.seen[notification.ID] {
+			continue
+		}
+		handler(notification)
+		delivered = append(delivered, notification.ID)
+	}
+	// only the current notifications are remembered, imgur drops viewed ones from the new list
+	w.seen = seen
+
+	if w.markViewed && len(delivered) > 0 {
+		if _, err := w.client.MarkNotificationsViewed(ctx, delivered...); err != nil {
+			w.client.Log.Warningf("Marking notifications as viewed failed: %v", err)
+		}
+	}
+	return nil
+}
+
+// AlbumEventType is the kind of change of an album
+type AlbumEventType int
+
+// Changes of an album reported by WatchAlbum
+const (
+	ImageAdded   AlbumEventType = iota // The image was added to the album
+	ImageRemoved                       // The image was removed from the album
+	ImageUpdated                       // Title, description or link of the image changed
+)
+
+func (t AlbumEventType) String() string {
+	switch t {
+	case ImageAdded:
+		return "added"
+	case ImageRemoved:
+		return "removed"
+	case ImageUpdated:
+		return "updated"
+	}
+	return "unknown"
+}
+
+// AlbumEvent is a change of an album
+type AlbumEvent struct {
+	Type  AlbumEventType
+	Image ImageInfo // The image after the change, or before it was removed
+}
+
+// WatchAlbum requests the album every interval and sends the changes of its images on
+// the returned channel, which is closed once ctx is done. The album as found by the
+// first request is the baseline and does not cause events. Failed requests are logged
+// and retried with the next interval. An interval that is not positive polls every minute.
+func (client *Client) WatchAlbum(ctx context.Context, albumID AlbumID, interval time.Duration) <-chan AlbumEvent {
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	c := make(chan AlbumEvent)
+	go func() {
+		defer close(c)
+		var known []ImageInfo
+		first := true
+		for {
+			album, _, err := client.GetAlbumInfoWithContext(ctx, albumID)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				client.Log.Warningf("Watching album %v failed: %v", albumID, err)
+			} else {
+				if !first {
+					for _, event := range diffAlbum(known, album.Images) {
+						select {
+						case c <- event:
+						case <-ctx.Done():
+							return
+						}
+					}
+				}
+				known, first = album.Images, false
+			}
+
+			if err := sleepContext(ctx, interval); err != nil {
+				return
+			}
+		}
+	}()
+	return c
+}
+
+// diffAlbum returns the changes from the images before to after, in album order
+func diffAlbum(before []ImageInfo, after []ImageInfo) []AlbumEvent {
+	old := make(map[ImageID]ImageInfo, len(before))
+	for _, img := range before {
+		old[img.ID] = img
+	}
+	current := make(map[ImageID]bool, len(after))
+	for _, img := range after {
+		current[img.ID] = true
+	}
+
+	var events []AlbumEvent
+	for _, img := range before {
+		if !current[img.ID] {
+			events = append(events, AlbumEvent{Type: ImageRemoved, Image: img})
+		}
+	}
+	for _, img := range after {
+		prev, ok := old[img.ID]
+		switch {
+		case !ok:
+			events = append(events, AlbumEvent{Type: ImageAdded, Image: img})
+		case prev.Title != img.Title || prev.Description != img.Description || prev.Link != img.Link:
+			events = append(events, AlbumEvent{Type: ImageUpdated, Image: img})
+		}
+	}
+	return events
+}
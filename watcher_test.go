@@ -0,0 +1,121 @@
+package imgur
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatcherDeliversOnce(t *testing.T) {
+	var polls int32
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		// the first reply stays unviewed, the second one appears with the third poll
+		replies := `{"id":1,"content":{"id":10,"comment":"first"}}`
+		if atomic.AddInt32(&polls, 1) >= 3 {
+			replies += `,{"id":2,"content":{"id":11,"comment":"second"}}`
+		}
+		fmt.Fprint(w, `{"data":{"replies":[`+replies+`],"messages":[]},"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"))
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var comments []string
+	for n := range client.NewWatcher(WithPollInterval(time.Millisecond)).Watch(ctx) {
+		comments = append(comments, n.Reply.Comment)
+		if len(comments) == 2 {
+			cancel()
+		}
+	}
+	require.Equal(t, []string{"first", "second"}, comments)
+	require.GreaterOrEqual(t, atomic.LoadInt32(&polls), int32(3))
+}
+
+func TestWatcherBackoff(t *testing.T) {
+	var polls int32
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&polls, 1) <= 2 {
+			w.WriteHeader(500)
+			return
+		}
+		fmt.Fprint(w, notificationsJSON)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "", WithAccessToken("access"), WithRetryPolicy(NoRetry))
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var ids []int
+	err := client.NewWatcher(WithPollInterval(time.Millisecond), WithMaxBackoff(4*time.Millisecond)).Run(ctx, func(n Notification) {
+		ids = append(ids, n.ID)
+		if len(ids) == 2 {
+			cancel()
+		}
+	})
+	require.Equal(t, context.Canceled, err)
+	require.Equal(t, []int{5, 6}, ids)
+	require.Equal(t, int32(3), atomic.LoadInt32(&polls))
+}
+
+func TestWatchAlbum(t *testing.T) {
+	versions := []string{
+		`[{"id":"a","title":"A"},{"id":"b","title":"B"}]`,
+		`[{"id":"a","title":"A"},{"id":"b","title":"B"}]`,
+		`[{"id":"a","title":"A2"},{"id":"c","title":"C"}]`,
+	}
+	var polls int32
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/3/album/VZQXk", r.URL.Path)
+		i := int(atomic.AddInt32(&polls, 1)) - 1
+		if i >= len(versions) {
+			i = len(versions) - 1
+		}
+		fmt.Fprint(w, `{"data":{"id":"VZQXk","images":`+versions[i]+`},"success":true,"status":200}`)
+	})
+	defer server.Close()
+
+	client, _ := NewClient(httpC, "testing", "")
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var events []string
+	for event := range client.WatchAlbum(ctx, "VZQXk", time.Millisecond) {
+		events = append(events, event.Type.String()+" "+string(event.Image.ID))
+		if len(events) == 3 {
+			cancel()
+		}
+	}
+	require.Equal(t, []string{"removed b", "updated a", "added c"}, events)
+}
+
+func TestWatcherNonPositiveIntervals(t *testing.T) {
+	client, _ := NewClient(new(http.Client), "testing", "", WithAccessToken("access"))
+	for _, interval := range []time.Duration{0, -time.Second} {
+		w := client.NewWatcher(WithPollInterval(interval), WithMaxBackoff(interval))
+		require.Equal(t, defaultPollInterval, w.interval)
+		require.Equal(t, defaultPollInterval, w.maxInterval)
+	}
+}
+
+func TestWatchAlbumNonPositiveInterval(t *testing.T) {
+	var requests int32
+	httpC, server := testHTTPClientHandler(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		fmt.Fprint(w, `{"data":{"id":"VZQXk","images":[]},"success":true,"status":200}`)
+	})
+	defer server.Close()
+	client, _ := NewClient(httpC, "testing", "")
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	for range client.WatchAlbum(ctx, "VZQXk", 0) {
+	}
+	require.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}